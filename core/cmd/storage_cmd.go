@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/events"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/rpc"
+	. "github.com/river-build/river/core/node/shared"
+
+	"github.com/spf13/cobra"
+)
+
+func runStorageVerify(
+	cfg *config.Config,
+	streamIds []string,
+	output string,
+	opts events.StorageVerifyOpts,
+	checkRegistry bool,
+) error {
+	ctx := context.Background() // lint:ignore context.Background() is fine here
+
+	for _, s := range streamIds {
+		id, err := StreamIdFromString(s)
+		if err != nil {
+			return err
+		}
+		opts.Streams = append(opts.Streams, id)
+	}
+
+	report, err := rpc.RunStorageVerify(ctx, cfg, opts, checkRegistry)
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if output != "" {
+		out, err = os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(report)
+	if err != nil {
+		return err
+	}
+
+	if report.FailedStreams > 0 {
+		return RiverError(
+			Err_DATA_LOSS,
+			"Storage verification failed",
+			"streams", report.Streams,
+			"failedStreams", report.FailedStreams,
+		)
+	}
+	return nil
+}
+
+func runStorageUsage(cfg *config.Config, topN int, interval time.Duration, output string) error {
+	ctx := context.Background() // lint:ignore context.Background() is fine here
+
+	report, err := rpc.RunStorageUsage(ctx, cfg, topN, interval)
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if output != "" {
+		out, err = os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+func init() {
+	storageCmd := &cobra.Command{
+		Use:   "storage",
+		Short: "Local stream storage commands",
+	}
+	rootCmd.AddCommand(storageCmd)
+
+	verifyCmd := &cobra.Command{
+		Use:   "verify [stream-id...]",
+		Short: "Verify hash chains, signatures and snapshots of streams in local storage",
+		Long: "Verify hash chains, signatures and snapshots of all streams (or given streams) in local storage\n" +
+			"and compare last miniblocks with the River registry. Report is printed as JSON.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			pageSize, err := cmd.Flags().GetInt("page-size")
+			if err != nil {
+				return err
+			}
+			onlyFailed, err := cmd.Flags().GetBool("only-failed")
+			if err != nil {
+				return err
+			}
+			skipRegistry, err := cmd.Flags().GetBool("skip-registry")
+			if err != nil {
+				return err
+			}
+			return runStorageVerify(
+				cmdConfig,
+				args,
+				output,
+				events.StorageVerifyOpts{PageSize: pageSize, OnlyFailed: onlyFailed},
+				!skipRegistry,
+			)
+		},
+	}
+	verifyCmd.Flags().String("output", "", "Write report to the file instead of stdout")
+	verifyCmd.Flags().Int("page-size", 100, "Number of miniblocks to read from storage at once")
+	verifyCmd.Flags().Bool("only-failed", false, "Only include streams with problems in the report")
+	verifyCmd.Flags().Bool("skip-registry", false, "Do not compare last miniblocks with the River registry")
+	storageCmd.AddCommand(verifyCmd)
+
+	usageCmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Report the largest and the fastest growing streams in local storage",
+		Long: "Report the number of rows and bytes stored for the largest streams of each stream type as JSON.\n" +
+			"If --interval is set, storage is sampled twice and the fastest growing streams are reported as well.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			top, err := cmd.Flags().GetInt("top")
+			if err != nil {
+				return err
+			}
+			interval, err := cmd.Flags().GetDuration("interval")
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return runStorageUsage(cmdConfig, top, interval, output)
+		},
+	}
+	usageCmd.Flags().Int("top", 20, "Number of streams of each type to report")
+	usageCmd.Flags().Duration("interval", 0, "Time between two samples used to find the fastest growing streams")
+	usageCmd.Flags().String("output", "", "Write report to the file instead of stdout")
+	storageCmd.AddCommand(usageCmd)
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/river-build/river/core/config"
+	"github.com/river-build/river/core/node/events"
+	"github.com/river-build/river/core/node/rpc"
+	. "github.com/river-build/river/core/node/shared"
+
+	"github.com/spf13/cobra"
+)
+
+func runStreamExport(cfg *config.Config, streamIdStr string, output string) error {
+	ctx := context.Background() // lint:ignore context.Background() is fine here
+
+	streamId, err := StreamIdFromString(streamIdStr)
+	if err != nil {
+		return err
+	}
+
+	// Archive is written to a temporary file first, so failed export doesn't leave partial archive at output.
+	tmp, err := os.CreateTemp(filepath.Dir(output), filepath.Base(output)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	err = rpc.RunStreamExport(ctx, cfg, streamId, tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), output)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+func runStreamImport(cfg *config.Config, file string, opts *events.StreamImportOpts) error {
+	ctx := context.Background() // lint:ignore context.Background() is fine here
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	archive, err := events.ReadStreamArchive(f)
+	if err != nil {
+		return err
+	}
+
+	// Validate before opening storage, so invalid files don't take over the database.
+	if _, _, err = archive.Validate(); err != nil {
+		return err
+	}
+
+	streamId, err := rpc.RunStreamImport(ctx, cfg, archive, opts)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Imported stream %s, miniblocks: %d, minipool events: %d\n",
+		streamId, archive.Metadata.LastMiniblock+1, len(archive.Minipool))
+	return nil
+}
+
+func init() {
+	streamCmd := &cobra.Command{
+		Use:   "stream",
+		Short: "Export and import streams in local storage",
+	}
+	rootCmd.AddCommand(streamCmd)
+
+	exportCmd := &cobra.Command{
+		Use:   "export <stream-id> <file>",
+		Short: "Export stream from local storage to the stream archive file",
+		Long:  "Export genesis, miniblocks and minipool of the stream from local storage to the stream archive file.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStreamExport(cmdConfig, args[0], args[1])
+		},
+	}
+	streamCmd.AddCommand(exportCmd)
+
+	importCmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import stream from the stream archive file into local storage",
+		Long: "Validate the stream archive file and import the stream into local storage.\n" +
+			"Stream must not exist in storage. Node should be stopped.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			archive, err := cmd.Flags().GetBool("archive")
+			if err != nil {
+				return err
+			}
+			return runStreamImport(cmdConfig, args[0], &events.StreamImportOpts{Archive: archive})
+		},
+	}
+	importCmd.Flags().Bool("archive", false, "Import into archive node storage, minipool is skipped")
+	streamCmd.AddCommand(importCmd)
+}
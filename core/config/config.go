@@ -19,6 +19,9 @@ func GetDefaultConfig() *Config {
 		Database: DatabaseConfig{
 			StartupDelay:  2 * time.Second,
 			NumPartitions: 256,
+			CandidateGC: CandidateGCConfig{
+				Interval: 10 * time.Minute,
+			},
 		},
 		StorageType:  "postgres",
 		DisableHttps: false,
@@ -39,7 +42,8 @@ func GetDefaultConfig() *Config {
 		// TODO: ArchitectContract: ContractConfig{},
 		// TODO: RegistryContract:  ContractConfig{},
 		StreamReconciliation: StreamReconciliationConfig{
-			WorkerPoolSize: 8,
+			WorkerPoolSize:      8,
+			AntiEntropyInterval: 10 * time.Minute,
 		},
 		Log: LogConfig{
 			Level:   "info", // NOTE: this default is replaced by flag value
@@ -93,9 +97,18 @@ type Config struct {
 	TLSConfig    TLSConfig
 
 	// Storage
-	Database    DatabaseConfig
+	Database DatabaseConfig
+
+	// StorageType is one of "postgres" (default), "leveldb" or "memory".
+	// Data stored in "memory" storage is lost on restart.
 	StorageType string
 
+	// EmbeddedStorage is used when StorageType is set to "leveldb".
+	EmbeddedStorage EmbeddedStorageConfig
+
+	// Cdc configures the change-data-capture feed of committed stream writes.
+	Cdc CdcConfig
+
 	// Blockchain configuration
 	BaseChain  ChainConfig
 	RiverChain ChainConfig
@@ -119,6 +132,18 @@ type Config struct {
 	// Stream reconciliation
 	StreamReconciliation StreamReconciliationConfig
 
+	// Stream cache memory budget
+	StreamCache StreamCacheConfig
+
+	// Miniblock production scheduling
+	MiniblockProducer MiniblockProducerConfig
+
+	// Rate limits for ephemeral events
+	EphemeralEvents EphemeralEventsConfig
+
+	// Authorization of stream deletion requests
+	StreamDeletion StreamDeletionConfig
+
 	// Network configuration
 	Network NetworkConfig
 
@@ -235,6 +260,143 @@ type DatabaseConfig struct {
 	// data storage. If <= 0, a default value of 256 will be used. No more than 256 partitions is
 	// supported at this time.
 	NumPartitions int
+
+	// Compression configures compression of miniblocks and minipool envelopes at rest.
+	Compression CompressionConfig
+
+	// ColdStorage configures offloading of old miniblocks to object storage.
+	ColdStorage ColdStorageConfig
+
+	// ReadReplicas configures optional read replicas used by read-only storage queries.
+	ReadReplicas ReadReplicasConfig
+
+	// CandidateGC configures background removal of stale miniblock candidates.
+	CandidateGC CandidateGCConfig
+}
+
+// CandidateGCConfig controls background removal of miniblock candidates that are no longer needed:
+// candidates below the last committed miniblock of their stream, candidates of deleted streams
+// and candidates that were not committed for longer than MaxAge.
+type CandidateGCConfig struct {
+	// Interval is the interval between background passes. If 0, stale candidates are not collected.
+	Interval time.Duration
+
+	// MaxAge is the age after which candidates are removed even if they are above the last
+	// committed miniblock. If 0, default value of 1h is used.
+	MaxAge time.Duration
+
+	// ExcessiveCandidates is the number of candidates of a single stream at which the stream is listed
+	// by the debug endpoint. If 0, default value of 10 is used.
+	ExcessiveCandidates int
+}
+
+// GetExcessiveCandidates returns ExcessiveCandidates or its default value if it's not set.
+func (c CandidateGCConfig) GetExcessiveCandidates() int {
+	if c.ExcessiveCandidates <= 0 {
+		return 10
+	}
+	return c.ExcessiveCandidates
+}
+
+// ReadReplicasConfig configures read replicas of the database. Read-only queries that tolerate
+// replication lag (reading miniblocks, debug reads) are sent to a replica if its lag is within MaxLag,
+// and are retried on the primary if the replica fails or hasn't caught up with the requested data.
+type ReadReplicasConfig struct {
+	// Urls is a comma-separated list of connection URLs of read replicas.
+	// Replicas must use the same database schema as the primary. If empty, all queries go to the primary.
+	Urls string `dlog:"omit" json:"-" yaml:"-"` // Sensitive data, omitted from logging.
+
+	// MaxLag is the maximum replication lag of a replica to be used. If 0, default value of 5s is used.
+	MaxLag time.Duration
+
+	// LagCheckInterval is the interval between replication lag checks. If 0, default value of 5s is used.
+	LagCheckInterval time.Duration
+}
+
+// GetUrls returns the list of replica URLs.
+func (c ReadReplicasConfig) GetUrls() []string {
+	var urls []string
+	for _, url := range strings.Split(c.Urls, ",") {
+		url = strings.TrimSpace(url)
+		if url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// CompressionConfig controls how miniblocks, miniblock candidates and minipool envelopes
+// are compressed before they are written to the database.
+// Each row carries its own codec marker, so rows written with any codec (or without compression)
+// remain readable after the configuration is changed.
+type CompressionConfig struct {
+	// Codec is used for newly written data. Allowed values: "none" (default), "zstd".
+	Codec string
+
+	// Level is the zstd compression level, 1 to 22. It is mapped to the closest level supported
+	// by the encoder. If 0, default level is used.
+	Level int
+
+	// MinSize is the minimum size of data in bytes to compress. Smaller data is stored as is.
+	// If 0, default value of 64 is used.
+	MinSize int
+
+	// Dictionaries maps stream type prefix in hex (i.e. "20" for channels, "a1" for user inboxes)
+	// to the path of zstd dictionary file trained on data of this stream type (i.e. with "zstd --train").
+	// Dictionaries are never removed from this list while data compressed with them is stored.
+	Dictionaries map[string]string
+
+	// RecompressionInterval is the interval between background passes that rewrite
+	// uncompressed miniblocks with the configured codec. If 0, recompression is disabled.
+	RecompressionInterval time.Duration
+
+	// RecompressionBatchSize is the number of miniblocks rewritten in a single transaction.
+	// If 0, default value of 100 is used.
+	RecompressionBatchSize int
+}
+
+// ColdStorageConfig controls offloading of old miniblocks from the database to object storage.
+// Offloaded miniblocks are replaced with a single index row per range and are fetched
+// from object storage on read.
+type ColdStorageConfig struct {
+	// Type of object storage. Allowed values: "" (cold storage is disabled), "fs", "s3".
+	// Once miniblocks are offloaded, cold storage must stay configured to be able to read them.
+	Type string
+
+	// Fs configures object storage in the local filesystem, used if Type is "fs".
+	Fs FsObjectStoreConfig
+
+	// S3 configures S3-compatible object storage, used if Type is "s3".
+	S3 S3ObjectStoreConfig
+
+	// KeepMiniblocks is the number of miniblocks before the last snapshot that are never offloaded.
+	KeepMiniblocks int
+
+	// MinAge is the minimum age of miniblocks to offload.
+	MinAge time.Duration
+
+	// RangeSize is the number of miniblocks stored in a single object. If 0, default value of 100 is used.
+	RangeSize int
+
+	// OffloadInterval is the interval between background offload passes. If 0, offloading is disabled,
+	// but previously offloaded miniblocks are still readable.
+	OffloadInterval time.Duration
+}
+
+type FsObjectStoreConfig struct {
+	// Path to the directory where objects are stored. Directory is created if it doesn't exist.
+	Path string
+}
+
+type S3ObjectStoreConfig struct {
+	// Endpoint is the URL of S3-compatible service, i.e. "https://s3.us-east-1.amazonaws.com".
+	Endpoint string
+	Region   string
+	Bucket   string
+	// Prefix is prepended to all object keys.
+	Prefix          string
+	AccessKeyId     string
+	SecretAccessKey string `dlog:"omit" json:"-" yaml:"-"` // Sensitive data, omitted from logging.
 }
 
 func (c DatabaseConfig) GetUrl() string {
@@ -253,6 +415,47 @@ func (c DatabaseConfig) GetUrl() string {
 	return c.Url
 }
 
+// EmbeddedStorageConfig configures the embedded key-value stream storage that keeps
+// all data in a single local directory and does not require an external database.
+type EmbeddedStorageConfig struct {
+	// DataDir is the directory where database files are stored. It is created if it doesn't exist.
+	DataDir string
+}
+
+const (
+	CdcSinkFile     = "file"
+	CdcSinkWebhook  = "webhook"
+	CdcSinkPgNotify = "pg_notify"
+)
+
+// CdcConfig configures the change-data-capture feed that publishes an ordered record
+// for every miniblock and minipool event committed to storage.
+type CdcConfig struct {
+	// Sink is one of "file", "webhook" or "pg_notify". If empty, the feed is disabled.
+	Sink string
+
+	// File is the path to the file or named pipe records are appended to as JSON lines.
+	File string
+
+	// WebhookUrl receives POST requests with JSON arrays of records.
+	WebhookUrl string `dlog:"omit" json:"-" yaml:"-"` // Sensitive data, omitted from logging.
+
+	// WebhookTimeout is the timeout of a single webhook request. If 0, default value of 10s is used.
+	WebhookTimeout time.Duration
+
+	// NotifyChannel is the Postgres channel records are sent to with NOTIFY.
+	// Requires postgres storage. If empty, "river_cdc" is used.
+	NotifyChannel string
+
+	// QueueSize is the number of records buffered for the sink. If sink falls behind and
+	// the queue is full, records are dropped: consumers detect gaps by record sequence numbers.
+	// If 0, default value of 10000 is used.
+	QueueSize int
+
+	// BatchSize is the maximum number of records sent to the sink at once. If 0, default value of 100 is used.
+	BatchSize int
+}
+
 // TransactionPoolConfig specifies when it is time for a replacement transaction and its gas fee costs.
 type TransactionPoolConfig struct {
 	// TransactionTimeout is the duration in which a transaction must be included in the chain before it is marked
@@ -452,6 +655,184 @@ type ScrubbingConfig struct {
 
 type StreamReconciliationConfig struct {
 	WorkerPoolSize int // If 0, default to 8.
+
+	// AntiEntropyInterval is how often local streams are compared with their replicas and the registry.
+	// If 0, anti-entropy scanning is disabled.
+	AntiEntropyInterval time.Duration
+
+	// AntiEntropyWorkers is the number of streams checked concurrently by the anti-entropy scan.
+	// If 0, default to 8.
+	AntiEntropyWorkers int
+
+	// AntiEntropyRequestTimeout limits each request to a replica made by the anti-entropy scan.
+	// If 0, default to 5 seconds.
+	AntiEntropyRequestTimeout time.Duration
+}
+
+func (c StreamReconciliationConfig) GetAntiEntropyWorkers() int {
+	if c.AntiEntropyWorkers <= 0 {
+		return 8
+	}
+	return c.AntiEntropyWorkers
+}
+
+func (c StreamReconciliationConfig) GetAntiEntropyRequestTimeout() time.Duration {
+	if c.AntiEntropyRequestTimeout <= 0 {
+		return 5 * time.Second
+	}
+	return c.AntiEntropyRequestTimeout
+}
+
+const (
+	StreamCacheEvictionLRU = "lru"
+	StreamCacheEvictionLFU = "lfu"
+)
+
+// StreamCacheConfig bounds memory used by stream views loaded into the stream cache.
+// Views are still unloaded after the on-chain stream cache expiration regardless of the budget.
+type StreamCacheConfig struct {
+	// MemoryBudget is the approximate number of bytes loaded stream views may use.
+	// When exceeded, views are evicted on the next cache cleanup pass. If 0, memory usage is not bounded.
+	MemoryBudget int64
+
+	// EvictionPolicy is "lru" to evict least recently used views first or "lfu" to evict
+	// least frequently used views first. If empty, defaults to "lru".
+	EvictionPolicy string
+
+	// HotStreamsFile is the path of the file where the list of recently written to or subscribed streams
+	// is persisted. On startup views of these streams are preloaded in the background.
+	// Should be on a volume that survives restarts. If empty, cache warmup is disabled.
+	HotStreamsFile string
+
+	// HotStreamsMaxAge is how long a stream stays in the hot list after the last write or subscription.
+	// If 0, defaults to 1 hour.
+	HotStreamsMaxAge time.Duration
+
+	// HotStreamsLimit is the maximum number of streams in the hot list. If 0, defaults to 10000.
+	HotStreamsLimit int
+
+	// HotStreamsPersistInterval is how often the hot list is written to the file, it is also written on shutdown.
+	// If 0, defaults to 1 minute.
+	HotStreamsPersistInterval time.Duration
+
+	// WarmupConcurrency is the number of concurrent batched storage reads during warmup. If 0, defaults to 4.
+	WarmupConcurrency int
+
+	// WarmupTimeout bounds the time the node reports WARMING_UP status on startup.
+	// Streams not loaded by then are loaded on demand. If 0, defaults to 5 minutes.
+	WarmupTimeout time.Duration
+}
+
+func (c StreamCacheConfig) GetHotStreamsMaxAge() time.Duration {
+	if c.HotStreamsMaxAge <= 0 {
+		return time.Hour
+	}
+	return c.HotStreamsMaxAge
+}
+
+func (c StreamCacheConfig) GetHotStreamsLimit() int {
+	if c.HotStreamsLimit <= 0 {
+		return 10000
+	}
+	return c.HotStreamsLimit
+}
+
+func (c StreamCacheConfig) GetHotStreamsPersistInterval() time.Duration {
+	if c.HotStreamsPersistInterval <= 0 {
+		return time.Minute
+	}
+	return c.HotStreamsPersistInterval
+}
+
+func (c StreamCacheConfig) GetWarmupConcurrency() int {
+	if c.WarmupConcurrency <= 0 {
+		return 4
+	}
+	return c.WarmupConcurrency
+}
+
+func (c StreamCacheConfig) GetWarmupTimeout() time.Duration {
+	if c.WarmupTimeout <= 0 {
+		return 5 * time.Minute
+	}
+	return c.WarmupTimeout
+}
+
+// MiniblockProducerConfig controls which streams get a miniblock production job on each new block.
+// Streams with pending events are ordered by priority: streams with more events in the minipool,
+// older pending events and latency sensitive types (user inboxes, DMs and GDMs) come first, media streams last.
+type MiniblockProducerConfig struct {
+	// MaxJobsPerBlock caps the number of miniblock production jobs started on a single block.
+	// Streams over the cap are retried on the next block. If 0, number of jobs is not limited.
+	MaxJobsPerBlock int
+
+	// StarvationTimeout is the age of the oldest pending event after which the stream is scheduled
+	// ahead of all streams with younger pending events regardless of their priority.
+	// If 0, default value of 10s is used.
+	StarvationTimeout time.Duration
+}
+
+func (c MiniblockProducerConfig) GetStarvationTimeout() time.Duration {
+	if c.StarvationTimeout <= 0 {
+		return 10 * time.Second
+	}
+	return c.StarvationTimeout
+}
+
+// EphemeralEventsConfig limits ephemeral events, such as typing indicators, that are delivered to
+// stream subscribers and replicas but never persisted. Limits are applied by the node that receives
+// the event from the client.
+type EphemeralEventsConfig struct {
+	// MaxPerUserPerSecond is the number of ephemeral events a single user can add to a single stream per second.
+	// If 0, default value of 5 is used.
+	MaxPerUserPerSecond int
+
+	// MaxPerStreamPerSecond is the number of ephemeral events all users can add to a single stream per second.
+	// If 0, default value of 100 is used.
+	MaxPerStreamPerSecond int
+}
+
+func (c EphemeralEventsConfig) GetMaxPerUserPerSecond() int {
+	if c.MaxPerUserPerSecond <= 0 {
+		return 5
+	}
+	return c.MaxPerUserPerSecond
+}
+
+func (c EphemeralEventsConfig) GetMaxPerStreamPerSecond() int {
+	if c.MaxPerStreamPerSecond <= 0 {
+		return 100
+	}
+	return c.MaxPerStreamPerSecond
+}
+
+// StreamDeletionConfig controls who can delete streams with the DeleteStream request.
+// Users can always delete streams that belong to them, such as their user settings or inbox streams.
+type StreamDeletionConfig struct {
+	// AllowedAddresses is a comma-separated list of addresses that can delete any stream.
+	AllowedAddresses string
+
+	// MaxRequestAge is how long the signed delete request is accepted after it was signed.
+	// If 0, default value of 5 minutes is used.
+	MaxRequestAge time.Duration
+}
+
+func (c StreamDeletionConfig) GetMaxRequestAge() time.Duration {
+	if c.MaxRequestAge <= 0 {
+		return 5 * time.Minute
+	}
+	return c.MaxRequestAge
+}
+
+// IsAllowedAddress returns true if the address can delete any stream.
+func (c StreamDeletionConfig) IsAllowedAddress(address common.Address) bool {
+	for _, a := range strings.Split(c.AllowedAddresses, ",") {
+		a = strings.TrimSpace(a)
+		if common.IsHexAddress(a) && common.HexToAddress(a) == address {
+			return true
+		}
+	}
+	return false
 }
 
 type FilterConfig struct {
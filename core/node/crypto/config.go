@@ -41,6 +41,23 @@ const (
 	StreamMinEventsPerSnapshotUserSettingsConfigKey = "stream.minEventsPerSnapshot.a5"
 	StreamMinEventsPerSnapshotUserConfigKey         = "stream.minEventsPerSnapshot.a8"
 	StreamMinEventsPerSnapshotUserDeviceConfigKey   = "stream.minEventsPerSnapshot.ad"
+	StreamDefaultMaxBytesPerSnapshotConfigKey       = "stream.defaultMaxBytesPerSnapshot"
+	StreamMaxBytesPerSnapshotChannelConfigKey       = "stream.maxBytesPerSnapshot.20"
+	StreamMaxBytesPerSnapshotMediaConfigKey         = "stream.maxBytesPerSnapshot.ff"
+	StreamDefaultMaxSnapshotAgeConfigKey            = "stream.defaultMaxSnapshotAgeSeconds"
+	StreamMaxSnapshotAgeChannelConfigKey            = "stream.maxSnapshotAge.20.ageSeconds"
+	StreamMaxSnapshotAgeMediaConfigKey              = "stream.maxSnapshotAge.ff.ageSeconds"
+	StreamDefaultRetainSnapshotsConfigKey           = "stream.defaultRetainSnapshots"
+	StreamRetainSnapshotsUserInboxConfigKey         = "stream.retainSnapshots.a1"
+	StreamRetainSnapshotsUserSettingsConfigKey      = "stream.retainSnapshots.a5"
+	StreamRetainSnapshotsMediaConfigKey             = "stream.retainSnapshots.ff"
+	StreamRetainSnapshotsChannelConfigKey           = "stream.retainSnapshots.20"
+	StreamRetentionPollIntervalMsConfigKey          = "stream.retentionPollIntervalMs"
+	StreamDefaultWriteConsistencyConfigKey          = "stream.defaultWriteConsistency"
+	StreamWriteConsistencyChannelConfigKey          = "stream.writeConsistency.20"
+	StreamWriteConsistencyDMChannelConfigKey        = "stream.writeConsistency.88"
+	StreamWriteConsistencyGDMChannelConfigKey       = "stream.writeConsistency.77"
+	StreamWriteConsistencyMediaConfigKey            = "stream.writeConsistency.ff"
 	StreamCacheExpirationMsConfigKey                = "stream.cacheExpirationMs"
 	StreamCacheExpirationPollIntervalMsConfigKey    = "stream.cacheExpirationPollIntervalMs"
 	MediaStreamMembershipLimitsGDMConfigKey         = "media.streamMembershipLimits.77"
@@ -92,6 +109,12 @@ type OnChainSettings struct {
 
 	MinSnapshotEvents MinSnapshotEventsSettings `mapstructure:",squash"`
 
+	SnapshotTriggers SnapshotTriggerSettings `mapstructure:",squash"`
+
+	Retention RetentionSettings `mapstructure:",squash"`
+
+	WriteConsistency WriteConsistencySettings `mapstructure:",squash"`
+
 	StreamCacheExpiration    time.Duration `mapstructure:"stream.cacheExpirationMs"`
 	StreamCachePollIntterval time.Duration `mapstructure:"stream.cacheExpirationPollIntervalMs"`
 
@@ -127,6 +150,131 @@ func (m MinSnapshotEventsSettings) ForType(streamType byte) uint64 {
 	}
 }
 
+// SnapshotTriggerSettings defines when a snapshot is taken regardless of the number of events since the last one:
+// when the size of the events since the last snapshot reaches MaxBytes or the last snapshot is older than MaxAge.
+// Zero disables the corresponding trigger for the stream type.
+type SnapshotTriggerSettings struct {
+	DefaultMaxBytes uint64 `mapstructure:"stream.defaultMaxBytesPerSnapshot"`
+	ChannelMaxBytes uint64 `mapstructure:"stream.maxBytesPerSnapshot.20"`
+	MediaMaxBytes   uint64 `mapstructure:"stream.maxBytesPerSnapshot.ff"`
+
+	DefaultMaxAge time.Duration `mapstructure:"stream.defaultMaxSnapshotAgeSeconds"`
+	ChannelMaxAge time.Duration `mapstructure:"stream.maxSnapshotAge.20.ageSeconds"`
+	MediaMaxAge   time.Duration `mapstructure:"stream.maxSnapshotAge.ff.ageSeconds"`
+}
+
+// MaxBytesForType returns the size of events since the last snapshot that triggers a new snapshot.
+func (s SnapshotTriggerSettings) MaxBytesForType(streamType byte) uint64 {
+	switch streamType {
+	case shared.STREAM_CHANNEL_BIN:
+		return s.ChannelMaxBytes
+	case shared.STREAM_MEDIA_BIN:
+		return s.MediaMaxBytes
+	default:
+		return s.DefaultMaxBytes
+	}
+}
+
+// MaxAgeForType returns the age of the last snapshot that triggers a new snapshot.
+func (s SnapshotTriggerSettings) MaxAgeForType(streamType byte) time.Duration {
+	switch streamType {
+	case shared.STREAM_CHANNEL_BIN:
+		return s.ChannelMaxAge
+	case shared.STREAM_MEDIA_BIN:
+		return s.MediaMaxAge
+	default:
+		return s.DefaultMaxAge
+	}
+}
+
+// RetentionSettings defines how much of the stream history is kept in storage.
+// Miniblocks preceding the N-th most recent snapshot are pruned, where N is configured per stream type.
+// Zero means history of the stream type is never pruned.
+type RetentionSettings struct {
+	Default      uint64 `mapstructure:"stream.defaultRetainSnapshots"`
+	UserInbox    uint64 `mapstructure:"stream.retainSnapshots.a1"`
+	UserSettings uint64 `mapstructure:"stream.retainSnapshots.a5"`
+	Media        uint64 `mapstructure:"stream.retainSnapshots.ff"`
+	Channel      uint64 `mapstructure:"stream.retainSnapshots.20"`
+
+	// PollInterval is how often local streams are checked for miniblocks to prune.
+	PollInterval time.Duration `mapstructure:"stream.retentionPollIntervalMs"`
+}
+
+// RetainSnapshotsForType returns number of most recent snapshots to retain for the given stream type.
+func (r RetentionSettings) RetainSnapshotsForType(streamType byte) uint64 {
+	switch streamType {
+	case shared.STREAM_USER_INBOX_BIN:
+		return r.UserInbox
+	case shared.STREAM_USER_SETTINGS_BIN:
+		return r.UserSettings
+	case shared.STREAM_MEDIA_BIN:
+		return r.Media
+	case shared.STREAM_CHANNEL_BIN:
+		return r.Channel
+	default:
+		return r.Default
+	}
+}
+
+// WriteConsistency defines how many replicas must acknowledge a write before it's reported as successful.
+type WriteConsistency uint64
+
+const (
+	// WriteConsistencyMajority requires the local node and a majority of all stream nodes.
+	WriteConsistencyMajority WriteConsistency = 0
+	// WriteConsistencyLocalOnly requires only the local node, replicas are updated in the background.
+	WriteConsistencyLocalOnly WriteConsistency = 1
+	// WriteConsistencyAll requires all stream nodes.
+	WriteConsistencyAll WriteConsistency = 2
+)
+
+func (c WriteConsistency) String() string {
+	switch c {
+	case WriteConsistencyMajority:
+		return "majority"
+	case WriteConsistencyLocalOnly:
+		return "local-only"
+	case WriteConsistencyAll:
+		return "all"
+	default:
+		return fmt.Sprintf("WriteConsistency(%d)", uint64(c))
+	}
+}
+
+// WriteConsistencySettings defines write consistency of AddEvent and miniblock candidate saving per stream type.
+// Unknown values are treated as majority.
+type WriteConsistencySettings struct {
+	Default    uint64 `mapstructure:"stream.defaultWriteConsistency"`
+	Channel    uint64 `mapstructure:"stream.writeConsistency.20"`
+	DMChannel  uint64 `mapstructure:"stream.writeConsistency.88"`
+	GDMChannel uint64 `mapstructure:"stream.writeConsistency.77"`
+	Media      uint64 `mapstructure:"stream.writeConsistency.ff"`
+}
+
+// ForType returns write consistency for the given stream type.
+func (w WriteConsistencySettings) ForType(streamType byte) WriteConsistency {
+	var c uint64
+	switch streamType {
+	case shared.STREAM_CHANNEL_BIN:
+		c = w.Channel
+	case shared.STREAM_DM_CHANNEL_BIN:
+		c = w.DMChannel
+	case shared.STREAM_GDM_CHANNEL_BIN:
+		c = w.GDMChannel
+	case shared.STREAM_MEDIA_BIN:
+		c = w.Media
+	default:
+		c = w.Default
+	}
+	switch WriteConsistency(c) {
+	case WriteConsistencyLocalOnly, WriteConsistencyAll:
+		return WriteConsistency(c)
+	default:
+		return WriteConsistencyMajority
+	}
+}
+
 type MembershipLimitsSettings struct {
 	GDM uint64 `mapstructure:"media.streamMembershipLimits.77"`
 	DM  uint64 `mapstructure:"media.streamMembershipLimits.88"`
@@ -161,6 +309,10 @@ func DefaultOnChainSettings() *OnChainSettings {
 			UserDevice:   10,
 		},
 
+		Retention: RetentionSettings{
+			PollInterval: 10 * time.Minute,
+		},
+
 		StreamCacheExpiration:    5 * time.Minute,
 		StreamCachePollIntterval: 30 * time.Second,
 
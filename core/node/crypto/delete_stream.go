@@ -0,0 +1,54 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+// String 'RIVERDEL' as bytes.
+var DELETE_STREAM_HASH_HEADER = []byte{82, 73, 86, 69, 82, 68, 69, 76}
+
+// RiverDeleteStreamHashSrc returns the message that is signed as an Ethereum message to request stream deletion.
+func RiverDeleteStreamHashSrc(streamId []byte, reason string, requestedAtMs int64) []byte {
+	writer := bytes.Buffer{}
+	writeOrPanic(&writer, DELETE_STREAM_HASH_HEADER)
+	writeOrPanic(&writer, streamId)
+	// Write request time as 64-bit little endian uint.
+	err := binary.Write(&writer, binary.LittleEndian, requestedAtMs)
+	if err != nil {
+		panic(err)
+	}
+	writeOrPanic(&writer, []byte(reason))
+	return writer.Bytes()
+}
+
+// CheckDeleteStreamSig checks that the delete stream request is signed by the expected address.
+func CheckDeleteStreamSig(
+	expectedAddress []byte,
+	streamId []byte,
+	reason string,
+	requestedAtMs int64,
+	signature []byte,
+) error {
+	recoveredAddress, err := recoverEthereumMessageSignerAddress(
+		RiverDeleteStreamHashSrc(streamId, reason, requestedAtMs),
+		signature,
+	)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(expectedAddress, recoveredAddress.Bytes()) {
+		return RiverError(
+			Err_BAD_EVENT_SIGNATURE,
+			"Bad delete stream request signature",
+			"computed address",
+			recoveredAddress,
+			"expected address",
+			expectedAddress,
+		)
+	}
+	return nil
+}
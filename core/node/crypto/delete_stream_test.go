@@ -0,0 +1,33 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/river-build/river/core/node/base/test"
+)
+
+func TestDeleteStreamSig(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+
+	wallet, err := NewWallet(ctx)
+	require.NoError(err)
+	otherWallet, err := NewWallet(ctx)
+	require.NoError(err)
+
+	streamId := []byte{0xa8, 1, 2, 3}
+	hash := accounts.TextHash(RiverDeleteStreamHashSrc(streamId, "user request", 1234567890))
+	sig, err := crypto.Sign(hash, wallet.PrivateKeyStruct)
+	require.NoError(err)
+	sig[64] += 27
+
+	require.NoError(CheckDeleteStreamSig(wallet.Address.Bytes(), streamId, "user request", 1234567890, sig))
+	require.Error(CheckDeleteStreamSig(otherWallet.Address.Bytes(), streamId, "user request", 1234567890, sig))
+	require.Error(CheckDeleteStreamSig(wallet.Address.Bytes(), streamId, "other reason", 1234567890, sig))
+	require.Error(CheckDeleteStreamSig(wallet.Address.Bytes(), streamId, "user request", 1234567891, sig))
+}
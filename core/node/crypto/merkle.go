@@ -0,0 +1,113 @@
+package crypto
+
+import (
+	"bytes"
+
+	"golang.org/x/crypto/sha3"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+// Event Merkle tree commits to the ordered list of event hashes of a miniblock.
+// Leaves are keccak256(0x00 || eventHash) and inner nodes are keccak256(0x01 || left || right),
+// distinct prefixes prevent passing an inner node off as a leaf.
+// A node without a sibling on its level is promoted to the next level as is.
+const (
+	merkleLeafPrefix = 0x00
+	merkleNodePrefix = 0x01
+)
+
+func merkleLeaf(eventHash []byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	writeOrPanic(hash, []byte{merkleLeafPrefix})
+	writeOrPanic(hash, eventHash)
+	return hash.Sum(nil)
+}
+
+func merkleNode(left []byte, right []byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	writeOrPanic(hash, []byte{merkleNodePrefix})
+	writeOrPanic(hash, left)
+	writeOrPanic(hash, right)
+	return hash.Sum(nil)
+}
+
+func merkleNextLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 < len(level) {
+			next = append(next, merkleNode(level[i], level[i+1]))
+		} else {
+			next = append(next, level[i])
+		}
+	}
+	return next
+}
+
+func merkleLeaves(eventHashes [][]byte) [][]byte {
+	leaves := make([][]byte, len(eventHashes))
+	for i, h := range eventHashes {
+		leaves[i] = merkleLeaf(h)
+	}
+	return leaves
+}
+
+// EventMerkleRoot returns the Merkle root over the given event hashes, nil if there are no events.
+func EventMerkleRoot(eventHashes [][]byte) []byte {
+	if len(eventHashes) == 0 {
+		return nil
+	}
+	level := merkleLeaves(eventHashes)
+	for len(level) > 1 {
+		level = merkleNextLevel(level)
+	}
+	return level[0]
+}
+
+// EventMerkleProof returns sibling hashes proving inclusion of the event at the given index,
+// ordered from the leaf level up to the root.
+func EventMerkleProof(eventHashes [][]byte, index int) ([][]byte, error) {
+	if index < 0 || index >= len(eventHashes) {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Event index out of range").
+			Func("EventMerkleProof").
+			Tags("index", index, "count", len(eventHashes))
+	}
+
+	var proof [][]byte
+	level := merkleLeaves(eventHashes)
+	for len(level) > 1 {
+		if sibling := index ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = merkleNextLevel(level)
+		index /= 2
+	}
+	return proof, nil
+}
+
+// VerifyEventMerkleProof checks that the event hash is at the given index of the list of count events
+// with the given Merkle root.
+func VerifyEventMerkleProof(root []byte, eventHash []byte, index int, count int, proof [][]byte) bool {
+	if index < 0 || index >= count {
+		return false
+	}
+
+	hash := merkleLeaf(eventHash)
+	p := 0
+	for n := count; n > 1; n = (n + 1) / 2 {
+		if sibling := index ^ 1; sibling < n {
+			if p >= len(proof) {
+				return false
+			}
+			if index%2 == 0 {
+				hash = merkleNode(hash, proof[p])
+			} else {
+				hash = merkleNode(proof[p], hash)
+			}
+			p++
+		}
+		index /= 2
+	}
+	return p == len(proof) && bytes.Equal(hash, root)
+}
@@ -0,0 +1,42 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventMerkleProof(t *testing.T) {
+	require := require.New(t)
+
+	require.Nil(EventMerkleRoot(nil))
+
+	for count := 1; count <= 9; count++ {
+		var hashes [][]byte
+		for i := range count {
+			hash := RiverHash([]byte{byte(count), byte(i)})
+			hashes = append(hashes, hash[:])
+		}
+		root := EventMerkleRoot(hashes)
+		require.Len(root, 32)
+
+		for i := range count {
+			proof, err := EventMerkleProof(hashes, i)
+			require.NoError(err)
+			require.True(VerifyEventMerkleProof(root, hashes[i], i, count, proof), "count %d index %d", count, i)
+
+			require.False(VerifyEventMerkleProof(root, hashes[(i+1)%count], i, count, proof) && count > 1)
+			if len(proof) > 0 {
+				require.False(VerifyEventMerkleProof(root, hashes[i], i, count, proof[1:]))
+				require.False(VerifyEventMerkleProof(root, hashes[i], i^1, count, proof))
+			}
+		}
+
+		_, err := EventMerkleProof(hashes, count)
+		require.Error(err)
+	}
+
+	// Single event root is not the event hash itself, so an inner node can't be passed off as an event.
+	hash := RiverHash([]byte("event"))
+	require.NotEqual(hash[:], EventMerkleRoot([][]byte{hash[:]}))
+}
@@ -0,0 +1,97 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+// MakeEventProof returns the inclusion proof of the event in the given miniblock.
+// NOT_FOUND is returned if the event is not in the miniblock and FAILED_PRECONDITION if the miniblock
+// was produced before event Merkle roots were introduced.
+func MakeEventProof(mb *Miniblock, eventHash common.Hash) (*GetEventProofResponse, error) {
+	info, err := NewMiniblockInfoFromProto(
+		mb,
+		NewMiniblockInfoFromProtoOpts{ExpectedBlockNumber: -1, DontParseEvents: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	header := info.header()
+	index := slices.IndexFunc(header.EventHashes, func(h []byte) bool { return bytes.Equal(h, eventHash[:]) })
+	if index < 0 {
+		return nil, RiverError(Err_NOT_FOUND, "Event not found in miniblock").
+			Func("MakeEventProof").
+			Tags("miniblockNum", info.Ref.Num, "eventHash", eventHash)
+	}
+	if len(header.EventMerkleRoot) == 0 {
+		return nil, RiverError(Err_FAILED_PRECONDITION, "Miniblock has no event Merkle root").
+			Func("MakeEventProof").
+			Tags("miniblockNum", info.Ref.Num, "eventHash", eventHash)
+	}
+	if !info.eventMerkleRootValid() {
+		return nil, RiverError(Err_BAD_BLOCK, "Event Merkle root mismatch").
+			Func("MakeEventProof").
+			Tag("miniblockNum", info.Ref.Num)
+	}
+
+	proof, err := crypto.EventMerkleProof(header.EventHashes, index)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetEventProofResponse{
+		MiniblockNum:    info.Ref.Num,
+		MiniblockHash:   info.Ref.Hash[:],
+		Header:          mb.Header,
+		EventIndex:      int64(index),
+		EventCount:      int64(len(header.EventHashes)),
+		EventMerkleRoot: header.EventMerkleRoot,
+		Proof:           proof,
+	}, nil
+}
+
+// FindEventProof returns the inclusion proof of the event in the stream. If miniblockNum is nil,
+// the event is searched in miniblocks since the last snapshot, newest first.
+func FindEventProof(
+	ctx context.Context,
+	stream Stream,
+	eventHash common.Hash,
+	miniblockNum *int64,
+) (*GetEventProofResponse, error) {
+	if miniblockNum != nil {
+		mbs, _, err := stream.GetMiniblocks(ctx, *miniblockNum, *miniblockNum+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(mbs) != 1 {
+			return nil, RiverError(Err_NOT_FOUND, "Miniblock not found").
+				Func("FindEventProof").
+				Tag("miniblockNum", *miniblockNum)
+		}
+		return MakeEventProof(mbs[0], eventHash)
+	}
+
+	view, err := stream.GetView(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mbs := view.MiniblocksFromLastSnapshot()
+	for i := len(mbs) - 1; i >= 0; i-- {
+		proof, err := MakeEventProof(mbs[i], eventHash)
+		if IsRiverErrorCode(err, Err_NOT_FOUND) {
+			continue
+		}
+		return proof, err
+	}
+	return nil, RiverError(Err_NOT_FOUND, "Event not found in miniblocks since last snapshot").
+		Func("FindEventProof").
+		Tag("eventHash", eventHash)
+}
@@ -0,0 +1,81 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+func TestMakeEventProof(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+	userWallet, _ := crypto.NewWallet(ctx)
+	nodeWallet, _ := crypto.NewWallet(ctx)
+	streamId := UserSettingStreamIdFromAddr(userWallet.Address)
+
+	genesis := MakeGenesisMiniblockForUserSettingsStream(t, userWallet, nodeWallet, streamId)
+	view, err := MakeStreamView(ctx, &storage.ReadStreamFromLastSnapshotResult{Miniblocks: [][]byte{toBytes(t, genesis)}})
+	require.NoError(err)
+
+	var events []*ParsedEvent
+	for range 5 {
+		event := MakeEvent(
+			t,
+			userWallet,
+			Make_UserSettingsPayload_FullyReadMarkers(&UserSettingsPayload_FullyReadMarkers{}),
+			genesis.Ref,
+		)
+		view, err = view.copyAndAddEvent(event)
+		require.NoError(err)
+		events = append(events, event)
+	}
+
+	proposal, err := view.ProposeNextMiniblock(ctx, crypto.DefaultOnChainSettings(), false)
+	require.NoError(err)
+	header, mbEvents, err := view.makeMiniblockHeader(ctx, proposal)
+	require.NoError(err)
+	require.NotEmpty(header.EventMerkleRoot)
+	mb, err := NewMiniblockInfoFromHeaderAndParsed(nodeWallet, header, mbEvents)
+	require.NoError(err)
+	require.True(mb.eventMerkleRootValid())
+
+	for _, event := range events {
+		proof, err := MakeEventProof(mb.Proto, event.Hash)
+		require.NoError(err)
+		require.EqualValues(1, proof.MiniblockNum)
+		require.Equal(mb.Ref.Hash[:], proof.MiniblockHash)
+		require.EqualValues(len(events), proof.EventCount)
+		require.Equal(header.EventMerkleRoot, proof.EventMerkleRoot)
+		require.True(crypto.VerifyEventMerkleProof(
+			proof.EventMerkleRoot,
+			event.Hash[:],
+			int(proof.EventIndex),
+			int(proof.EventCount),
+			proof.Proof,
+		))
+
+		// Root is bound to the miniblock hash through the header.
+		parsedHeader, err := ParseEvent(proof.Header)
+		require.NoError(err)
+		require.Equal(mb.Ref.Hash, parsedHeader.Hash)
+		require.Equal(proof.EventMerkleRoot, parsedHeader.Event.GetMiniblockHeader().EventMerkleRoot)
+	}
+
+	_, err = MakeEventProof(mb.Proto, genesis.headerEvent.Hash)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+
+	// Miniblocks without Merkle root can't produce proofs.
+	legacy := MakeTestBlockForUserSettingsStream(t, userWallet, nodeWallet, genesis)
+	require.True(legacy.eventMerkleRootValid())
+	_, err = MakeEventProof(legacy.Proto, common.BytesToHash(legacy.header().EventHashes[0]))
+	require.Equal(Err_FAILED_PRECONDITION, AsRiverError(err).Code)
+}
@@ -184,6 +184,16 @@ func Make_MemberPayload_Username(username *EncryptedData) *StreamEvent_MemberPay
 	}
 }
 
+func Make_MemberPayload_Seal(reason string) *StreamEvent_MemberPayload {
+	return &StreamEvent_MemberPayload{
+		MemberPayload: &MemberPayload{
+			Content: &MemberPayload_Seal_{
+				Seal: &MemberPayload_Seal{Reason: reason},
+			},
+		},
+	}
+}
+
 func Make_MemberPayload_DisplayName(displayName *EncryptedData) *StreamEvent_MemberPayload {
 	return &StreamEvent_MemberPayload{
 		MemberPayload: &MemberPayload{
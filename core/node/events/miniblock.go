@@ -1,6 +1,9 @@
 package events
 
 import (
+	"bytes"
+	"sync/atomic"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	. "github.com/river-build/river/core/node/base"
@@ -95,6 +98,19 @@ type MiniblockInfo struct {
 	headerEvent        *ParsedEvent
 	useGetterForEvents []*ParsedEvent // Use events(). Getter checks if events have been initialized.
 	Proto              *Miniblock
+
+	// size is the memoized serialized size of Proto, 0 if not computed yet. Use sizeBytes().
+	size atomic.Int64
+}
+
+// sizeBytes returns the serialized size of the miniblock.
+func (b *MiniblockInfo) sizeBytes() int64 {
+	size := b.size.Load()
+	if size == 0 {
+		size = int64(proto.Size(b.Proto))
+		b.size.Store(size)
+	}
+	return size
 }
 
 func (b *MiniblockInfo) events() []*ParsedEvent {
@@ -108,6 +124,13 @@ func (b *MiniblockInfo) header() *MiniblockHeader {
 	return b.headerEvent.Event.GetMiniblockHeader()
 }
 
+// eventMerkleRootValid returns true if the header commits to the Merkle root over its event hashes
+// or has no root, as miniblocks produced before roots were introduced.
+func (b *MiniblockInfo) eventMerkleRootValid() bool {
+	root := b.header().EventMerkleRoot
+	return len(root) == 0 || bytes.Equal(root, crypto.EventMerkleRoot(b.header().EventHashes))
+}
+
 func (b *MiniblockInfo) lastEvent() *ParsedEvent {
 	events := b.events()
 	if len(events) > 0 {
@@ -3,11 +3,13 @@ package events
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"slices"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/river-build/river/core/contracts/river"
 	. "github.com/river-build/river/core/node/base"
@@ -48,6 +50,13 @@ type RemoteMiniblockProvider interface {
 		fromMiniBlockNum int64, // inclusive
 		toMiniBlockNum int64, // exclusive
 	) <-chan *MbOrError
+
+	// GetLastMbRef returns the last miniblock of the given stream on the given node.
+	GetLastMbRef(
+		ctx context.Context,
+		node common.Address,
+		streamId StreamId,
+	) (*MiniblockRef, error)
 }
 
 type MbOrError struct {
@@ -83,8 +92,26 @@ func NewMiniblockProducer(
 	streamCache StreamCache,
 	opts *MiniblockProducerOpts,
 ) *miniblockProducer {
+	params := streamCache.Params()
 	mb := &miniblockProducer{
 		streamCache: streamCache,
+		pendingLatency: params.Metrics.NewHistogramVecEx(
+			"miniblock_producer_pending_event_latency_seconds",
+			"Time from the oldest pending event reaching the minipool to its miniblock being applied",
+			[]float64{0.1, 0.25, 0.5, 1, 2, 5, 10, 30, 60},
+			"chain_id", "address", "stream_type",
+		).MustCurryWith(prometheus.Labels{
+			"chain_id": params.RiverChain.ChainId.String(),
+			"address":  params.Wallet.Address.String(),
+		}),
+		deferredStreams: params.Metrics.NewCounterVecEx(
+			"miniblock_producer_deferred_streams",
+			"Number of streams with pending events not scheduled on a block because of the jobs per block cap",
+			"chain_id", "address",
+		).WithLabelValues(
+			params.RiverChain.ChainId.String(),
+			params.Wallet.Address.String(),
+		),
 	}
 	if opts != nil {
 		mb.opts = *opts
@@ -107,6 +134,9 @@ type miniblockProducer struct {
 	candidates candidateTracker
 
 	onNewBlockMutex sync.Mutex
+
+	pendingLatency  prometheus.ObserverVec
+	deferredStreams prometheus.Counter
 }
 
 var _ MiniblockProducer = (*miniblockProducer)(nil)
@@ -115,6 +145,8 @@ var _ MiniblockProducer = (*miniblockProducer)(nil)
 type mbJob struct {
 	stream    *streamImpl
 	candidate *MiniblockInfo
+	// pendingSince is the time the oldest pending event reached the minipool, zero if not tracked.
+	pendingSince time.Time
 }
 
 // candidateTracker is a helper struct to accumulate proposals and call SetStreamLastMiniblockBatch.
@@ -178,17 +210,39 @@ func (p *miniblockProducer) OnNewBlock(ctx context.Context, _ crypto.BlockNumber
 	}()
 }
 
+// scheduleCandidates starts miniblock production jobs for streams with pending events where this node is the leader.
+// Streams are scheduled in priority order, see sortMbCandidateStreams. If MaxJobsPerBlock is set,
+// remaining streams are deferred to the next block; their pending events keep aging, so they are not starved.
 func (p *miniblockProducer) scheduleCandidates(ctx context.Context) []*mbJob {
-	candidates := p.streamCache.GetMbCandidateStreams(ctx)
+	cfg := p.streamCache.Params().Config.MiniblockProducer
+	streams := p.streamCache.GetMbCandidateStreams(ctx)
 
-	var scheduled []*mbJob
-
-	for _, stream := range candidates {
+	now := time.Now()
+	var candidates []*mbCandidateStream
+	for _, stream := range streams {
 		// TODO: actual logic
 		if !stream.nodes.LocalIsLeader() {
 			continue
 		}
-		j := p.trySchedule(ctx, stream)
+		events, pendingSince := stream.pendingStatus()
+		candidates = append(candidates, newMbCandidateStream(
+			stream,
+			stream.streamId.Type(),
+			events,
+			pendingSince,
+			now,
+			cfg.GetStarvationTimeout(),
+		))
+	}
+	sortMbCandidateStreams(candidates)
+
+	var scheduled []*mbJob
+	for i, c := range candidates {
+		if cfg.MaxJobsPerBlock > 0 && len(scheduled) >= cfg.MaxJobsPerBlock {
+			p.deferredStreams.Add(float64(len(candidates) - i))
+			break
+		}
+		j := p.trySchedule(ctx, c.stream)
 		if j != nil {
 			scheduled = append(scheduled, j)
 		}
@@ -198,8 +252,10 @@ func (p *miniblockProducer) scheduleCandidates(ctx context.Context) []*mbJob {
 }
 
 func (p *miniblockProducer) trySchedule(ctx context.Context, stream *streamImpl) *mbJob {
+	_, pendingSince := stream.pendingStatus()
 	j := &mbJob{
-		stream: stream,
+		stream:       stream,
+		pendingSince: pendingSince,
 	}
 	_, prevLoaded := p.jobs.LoadOrStore(stream.streamId, j)
 	if !prevLoaded {
@@ -478,7 +534,10 @@ func mbProduceCandiate_Save(
 	mbInfo *MiniblockInfo,
 	remoteNodes []common.Address,
 ) error {
-	qp := NewQuorumPool(len(remoteNodes))
+	qp := NewQuorumPoolWithConsistency(
+		len(remoteNodes),
+		params.ChainConfig.Get().WriteConsistency.ForType(streamId.Type()),
+	)
 
 	qp.GoLocal(func() error {
 		miniblockBytes, err := mbInfo.ToBytes()
@@ -549,7 +608,7 @@ func (p *miniblockProducer) submitProposalBatch(ctx context.Context, proposals [
 			job.candidate.headerEvent.MiniblockRef.Hash,
 			job.candidate.headerEvent.Hash,
 			uint64(job.candidate.Ref.Num),
-			false,
+			job.candidate.isSealed(),
 		)
 		if err != nil {
 			log.Error("submitProposalBatch: Error registering miniblock", "streamId", job.stream.streamId, "err", err)
@@ -566,7 +625,7 @@ func (p *miniblockProducer) submitProposalBatch(ctx context.Context, proposals [
 					PrevMiniBlockHash: job.candidate.headerEvent.MiniblockRef.Hash,
 					LastMiniblockHash: job.candidate.headerEvent.Hash,
 					LastMiniblockNum:  uint64(job.candidate.Ref.Num),
-					IsSealed:          false,
+					IsSealed:          job.candidate.isSealed(),
 				},
 			)
 		}
@@ -594,6 +653,10 @@ func (p *miniblockProducer) submitProposalBatch(ctx context.Context, proposals [
 					"err",
 					err,
 				)
+			} else if !job.pendingSince.IsZero() {
+				p.pendingLatency.
+					WithLabelValues(hex.EncodeToString([]byte{job.stream.streamId.Type()})).
+					Observe(time.Since(job.pendingSince).Seconds())
 			}
 		}
 		p.jobDone(ctx, job)
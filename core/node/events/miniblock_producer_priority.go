@@ -0,0 +1,86 @@
+package events
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// mbCandidateStream is a stream with pending events considered for miniblock production on a new block.
+type mbCandidateStream struct {
+	stream       *streamImpl
+	events       int
+	pendingSince time.Time
+	starved      bool
+	priority     float64
+}
+
+// streamTypePriorityWeight returns the weight of the stream type in miniblock production priority.
+// Streams that users wait on for message delivery come first, media streams last.
+func streamTypePriorityWeight(streamType byte) float64 {
+	switch streamType {
+	case STREAM_USER_INBOX_BIN, STREAM_DM_CHANNEL_BIN, STREAM_GDM_CHANNEL_BIN:
+		return 4
+	case STREAM_CHANNEL_BIN, STREAM_SPACE_BIN:
+		return 3
+	case STREAM_USER_BIN, STREAM_USER_SETTINGS_BIN, STREAM_USER_METADATA_KEY_BIN:
+		return 2
+	default:
+		return 1
+	}
+}
+
+func (s *streamImpl) pendingStatus() (int, time.Time) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.view() == nil {
+		return 0, time.Time{}
+	}
+	return s.view().minipool.events.Len(), s.pendingSince
+}
+
+// newMbCandidateStream computes the priority of the stream. Priority grows with the stream type weight,
+// logarithmically with the number of pending events and linearly with the age of the oldest pending event,
+// so a stream that keeps losing to higher priority streams eventually wins.
+func newMbCandidateStream(
+	stream *streamImpl,
+	streamType byte,
+	events int,
+	pendingSince time.Time,
+	now time.Time,
+	starvationTimeout time.Duration,
+) *mbCandidateStream {
+	if pendingSince.IsZero() || pendingSince.After(now) {
+		pendingSince = now
+	}
+	age := now.Sub(pendingSince)
+	return &mbCandidateStream{
+		stream:       stream,
+		events:       events,
+		pendingSince: pendingSince,
+		starved:      age >= starvationTimeout,
+		priority: streamTypePriorityWeight(streamType) *
+			(1 + math.Log2(1+float64(events))) *
+			(1 + age.Seconds()),
+	}
+}
+
+// sortMbCandidateStreams orders streams for miniblock production: starved streams first, oldest pending events first,
+// then the rest by priority, highest first.
+func sortMbCandidateStreams(candidates []*mbCandidateStream) {
+	slices.SortStableFunc(candidates, func(a, b *mbCandidateStream) int {
+		if a.starved != b.starved {
+			if a.starved {
+				return -1
+			}
+			return 1
+		}
+		if a.starved {
+			return a.pendingSince.Compare(b.pendingSince)
+		}
+		return cmp.Compare(b.priority, a.priority)
+	})
+}
@@ -0,0 +1,97 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/shared"
+)
+
+func TestSortMbCandidateStreams(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Now()
+	starvation := 10 * time.Second
+	candidate := func(name string, streamType byte, events int, age time.Duration) *mbCandidateStream {
+		c := newMbCandidateStream(nil, streamType, events, now.Add(-age), now, starvation)
+		c.stream = &streamImpl{}
+		c.stream.streamId[1] = name[0]
+		return c
+	}
+	order := func(candidates ...*mbCandidateStream) string {
+		sortMbCandidateStreams(candidates)
+		var ret []byte
+		for _, c := range candidates {
+			ret = append(ret, c.stream.streamId[1])
+		}
+		return string(ret)
+	}
+
+	// Stream type decides between streams with the same pending events.
+	require.Equal("idcm", order(
+		candidate("m", STREAM_MEDIA_BIN, 1, time.Second),
+		candidate("c", STREAM_CHANNEL_BIN, 1, time.Second),
+		candidate("i", STREAM_USER_INBOX_BIN, 1, time.Second),
+		candidate("d", STREAM_DM_CHANNEL_BIN, 1, time.Second),
+	))
+
+	// Larger minipool and older pending events raise priority.
+	require.Equal("lsy", order(
+		candidate("y", STREAM_CHANNEL_BIN, 1, 0),
+		candidate("s", STREAM_CHANNEL_BIN, 1, 2*time.Second),
+		candidate("l", STREAM_CHANNEL_BIN, 100, 2*time.Second),
+	))
+
+	// Media stream overtakes inbox once its pending events are old enough.
+	require.Equal("mi", order(
+		candidate("i", STREAM_USER_INBOX_BIN, 1, 0),
+		candidate("m", STREAM_MEDIA_BIN, 1, 5*time.Second),
+	))
+
+	// Starved streams go first, oldest first, regardless of type and minipool size.
+	require.Equal("ABi", order(
+		candidate("i", STREAM_USER_INBOX_BIN, 1000, 9*time.Second),
+		candidate("B", STREAM_MEDIA_BIN, 1, 11*time.Second),
+		candidate("A", STREAM_MEDIA_BIN, 1, 20*time.Second),
+	))
+}
+
+func TestScheduleCandidatesMaxJobsPerBlock(t *testing.T) {
+	ctx, tc := makeCacheTestContext(t, testParams{})
+	require := require.New(t)
+
+	tc.instances[0].params.Config.MiniblockProducer.MaxJobsPerBlock = 2
+	streamCache := tc.initCache(0, &MiniblockProducerOpts{TestDisableMbProdcutionOnBlock: true})
+	mbProducer := tc.instances[0].mbProducer
+
+	for streamId, genesis := range tc.allocateStreams(3) {
+		stream, err := streamCache.GetStream(ctx, streamId)
+		require.NoError(err)
+		addEventToStream(t, ctx, streamCache.params, stream, "msg",
+			&MiniblockRef{Hash: common.BytesToHash(genesis.Header.Hash), Num: 0})
+	}
+
+	jobs := mbProducer.scheduleCandidates(ctx)
+	require.Len(jobs, 2)
+	for _, j := range jobs {
+		require.False(j.pendingSince.IsZero())
+	}
+	require.Eventually(
+		func() bool { return mbProducer.testCheckAllDone(jobs) },
+		20*time.Second,
+		10*time.Millisecond,
+	)
+
+	// Deferred stream is scheduled on the next block.
+	jobs = mbProducer.scheduleCandidates(ctx)
+	require.Len(jobs, 1)
+	require.Eventually(
+		func() bool { return mbProducer.testCheckAllDone(jobs) },
+		20*time.Second,
+		10*time.Millisecond,
+	)
+	require.Empty(mbProducer.scheduleCandidates(ctx))
+}
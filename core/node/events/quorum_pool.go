@@ -2,20 +2,36 @@ package events
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/river-build/river/core/node/crypto"
 )
 
+type remoteResult struct {
+	node common.Address
+	err  error
+}
+
 type QuorumPool struct {
+	consistency      crypto.WriteConsistency
 	localErrChannel  chan error
 	remotes          int
-	remoteErrChannel chan error
+	remoteErrChannel chan remoteResult
+	acknowledged     []common.Address
 }
 
 func NewQuorumPool(maxRemotes int) *QuorumPool {
-	var remoteErrChannel chan error
+	return NewQuorumPoolWithConsistency(maxRemotes, crypto.WriteConsistencyMajority)
+}
+
+// NewQuorumPoolWithConsistency creates a pool that waits for the number of remotes required by the given consistency.
+// Remotes that are not waited for keep running in the background after Wait returns.
+func NewQuorumPoolWithConsistency(maxRemotes int, consistency crypto.WriteConsistency) *QuorumPool {
+	var remoteErrChannel chan remoteResult
 	if maxRemotes > 0 {
-		remoteErrChannel = make(chan error, maxRemotes)
+		remoteErrChannel = make(chan remoteResult, maxRemotes)
 	}
 	return &QuorumPool{
+		consistency:      consistency,
 		remoteErrChannel: remoteErrChannel,
 	}
 }
@@ -32,7 +48,7 @@ func (q *QuorumPool) GoRemote(node common.Address, f func(node common.Address) e
 	q.remotes++
 	go func(node common.Address) {
 		err := f(node)
-		q.remoteErrChannel <- err
+		q.remoteErrChannel <- remoteResult{node: node, err: err}
 	}(node)
 }
 
@@ -44,23 +60,27 @@ func (q *QuorumPool) Wait() error {
 		}
 	}
 
-	// Then wait for majority quorum of remotes.
+	// Then wait for quorum of remotes.
 	if q.remotes > 0 {
-		remoteQuorum := RemoteQuorumNum(q.remotes, q.localErrChannel != nil)
+		remoteQuorum := q.remoteQuorumNum()
+		if remoteQuorum == 0 {
+			return nil
+		}
 
 		var firstErr error
 		success := 0
 		failure := 0
 		for i := 0; i < q.remotes; i++ {
-			err := <-q.remoteErrChannel
-			if err == nil {
+			res := <-q.remoteErrChannel
+			if res.err == nil {
+				q.acknowledged = append(q.acknowledged, res.node)
 				success++
 				if success >= remoteQuorum {
 					return nil
 				}
 			} else {
 				if firstErr == nil {
-					firstErr = err
+					firstErr = res.err
 				}
 				failure++
 				if failure > q.remotes-remoteQuorum {
@@ -75,6 +95,26 @@ func (q *QuorumPool) Wait() error {
 	return nil
 }
 
+// AcknowledgedRemotes returns remotes that succeeded before Wait returned.
+func (q *QuorumPool) AcknowledgedRemotes() []common.Address {
+	return q.acknowledged
+}
+
+func (q *QuorumPool) remoteQuorumNum() int {
+	switch q.consistency {
+	case crypto.WriteConsistencyLocalOnly:
+		if q.localErrChannel != nil {
+			return 0
+		}
+		// Without local write at least one remote is required.
+		return 1
+	case crypto.WriteConsistencyAll:
+		return q.remotes
+	default:
+		return RemoteQuorumNum(q.remotes, q.localErrChannel != nil)
+	}
+}
+
 func TotalQuorumNum(totalNumNodes int) int {
 	return (totalNumNodes + 1) / 2
 }
@@ -0,0 +1,50 @@
+package events
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/river-build/river/core/node/crypto"
+)
+
+func TestQuorumPoolConsistency(t *testing.T) {
+	errDropped := errors.New("dropped")
+	ok := func(common.Address) error { return nil }
+	drop := func(common.Address) error { return errDropped }
+	n1, n2, n3 := common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")
+
+	tests := []struct {
+		name        string
+		consistency crypto.WriteConsistency
+		local       bool
+		remotes     []func(common.Address) error
+		err         error
+		acked       []common.Address
+	}{
+		{"majority", crypto.WriteConsistencyMajority, true, []func(common.Address) error{drop, ok}, nil, []common.Address{n2}},
+		{"majority fails", crypto.WriteConsistencyMajority, true, []func(common.Address) error{drop, drop}, errDropped, nil},
+		{"all", crypto.WriteConsistencyAll, true, []func(common.Address) error{ok, ok}, nil, []common.Address{n1, n2}},
+		{"all fails", crypto.WriteConsistencyAll, true, []func(common.Address) error{ok, drop}, errDropped, nil},
+		{"local only", crypto.WriteConsistencyLocalOnly, true, []func(common.Address) error{drop, drop}, nil, nil},
+		{"local only without local", crypto.WriteConsistencyLocalOnly, false, []func(common.Address) error{drop, drop, ok}, nil, []common.Address{n3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qp := NewQuorumPoolWithConsistency(len(tt.remotes), tt.consistency)
+			if tt.local {
+				qp.GoLocal(func() error { return nil })
+			}
+			for i, f := range tt.remotes {
+				qp.GoRemote([]common.Address{n1, n2, n3}[i], f)
+			}
+			err := qp.Wait()
+			require.ErrorIs(t, err, tt.err)
+			if err == nil {
+				require.ElementsMatch(t, tt.acked, qp.AcknowledgedRemotes())
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/registries"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+// Kinds of problems reported by VerifyStorage.
+const (
+	StorageProblemRead        = "read"
+	StorageProblemMiniblock   = "miniblock"
+	StorageProblemPrevHash    = "prev_hash"
+	StorageProblemEventHashes = "event_hashes"
+	StorageProblemMerkleRoot  = "merkle_root"
+	StorageProblemSnapshot    = "snapshot"
+	StorageProblemRegistry    = "registry"
+)
+
+const storageVerifyDefaultPageSize = 100
+
+// StorageVerifyOpts configures VerifyStorage.
+type StorageVerifyOpts struct {
+	// Registry is used to check last miniblocks of streams. Registry check is skipped if nil.
+	Registry *registries.RiverRegistryContract
+	// Streams to verify. All streams in storage are verified if empty.
+	Streams []StreamId
+	// PageSize is the number of miniblocks read from storage at once.
+	PageSize int
+	// OnlyFailed limits report results to streams with problems.
+	OnlyFailed bool
+}
+
+// StorageVerifyReport is the machine-readable result of VerifyStorage.
+type StorageVerifyReport struct {
+	Streams       int                   `json:"streams"`
+	FailedStreams int                   `json:"failedStreams"`
+	Miniblocks    int64                 `json:"miniblocks"`
+	Results       []*StreamVerifyResult `json:"results"`
+}
+
+// StreamVerifyResult describes state of a single stream in storage.
+type StreamVerifyResult struct {
+	StreamId          string                 `json:"streamId"`
+	FirstMiniblock    int64                  `json:"firstMiniblock"`
+	LastMiniblock     int64                  `json:"lastMiniblock"`
+	LastSnapshot      int64                  `json:"lastSnapshot"`
+	RegistryMiniblock int64                  `json:"registryMiniblock"`
+	Problems          []*StreamVerifyProblem `json:"problems,omitempty"`
+}
+
+// StreamVerifyProblem is a single inconsistency found in stream storage.
+// Miniblock is -1 if problem is not related to a specific miniblock.
+type StreamVerifyProblem struct {
+	Kind      string `json:"kind"`
+	Miniblock int64  `json:"miniblock"`
+	Message   string `json:"message"`
+}
+
+func (r *StreamVerifyResult) addProblem(kind string, miniblock int64, format string, args ...any) {
+	r.Problems = append(r.Problems, &StreamVerifyProblem{
+		Kind:      kind,
+		Miniblock: miniblock,
+		Message:   fmt.Sprintf(format, args...),
+	})
+}
+
+// VerifyStorage walks streams in the given storage and checks that miniblocks form a valid hash chain,
+// event hashes and signatures are valid, snapshot markers are consistent with the last snapshot recorded
+// by storage and the last miniblock matches the River registry record.
+// Problems with the data are reported in the result, error is returned only if the walk can't be completed.
+func VerifyStorage(
+	ctx context.Context,
+	store storage.StreamStorage,
+	opts *StorageVerifyOpts,
+) (*StorageVerifyReport, error) {
+	log := dlog.FromCtx(ctx)
+
+	streamIds := opts.Streams
+	if len(streamIds) == 0 {
+		var err error
+		streamIds, err = store.GetStreams(ctx)
+		if err != nil {
+			return nil, AsRiverError(err).Func("VerifyStorage")
+		}
+	}
+
+	report := &StorageVerifyReport{
+		Results: []*StreamVerifyResult{},
+	}
+	for _, streamId := range streamIds {
+		if err := ctx.Err(); err != nil {
+			return nil, AsRiverError(err).Func("VerifyStorage")
+		}
+
+		result, miniblocks := verifyStream(ctx, store, opts, streamId)
+		report.Streams++
+		report.Miniblocks += miniblocks
+		if len(result.Problems) > 0 {
+			report.FailedStreams++
+			log.Warn("VerifyStorage: stream has problems", "streamId", streamId, "problems", len(result.Problems))
+		}
+		if len(result.Problems) > 0 || !opts.OnlyFailed {
+			report.Results = append(report.Results, result)
+		}
+	}
+	return report, nil
+}
+
+func verifyStream(
+	ctx context.Context,
+	store storage.StreamStorage,
+	opts *StorageVerifyOpts,
+	streamId StreamId,
+) (*StreamVerifyResult, int64) {
+	result := &StreamVerifyResult{
+		StreamId:          streamId.String(),
+		FirstMiniblock:    -1,
+		LastMiniblock:     -1,
+		LastSnapshot:      -1,
+		RegistryMiniblock: -1,
+	}
+
+	lastMb, err := store.StreamLastMiniBlock(ctx, streamId)
+	if err != nil {
+		result.addProblem(StorageProblemRead, -1, "Failed to read last miniblock: %v", err)
+		return result, 0
+	}
+	result.LastMiniblock = lastMb.Number
+
+	snapshot, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	if err != nil {
+		result.addProblem(StorageProblemRead, -1, "Failed to read last snapshot: %v", err)
+		return result, 0
+	}
+	result.LastSnapshot = snapshot.StartMiniblockNumber + int64(snapshot.SnapshotMiniblockOffset)
+
+	pageSize := int64(opts.PageSize)
+	if pageSize <= 0 {
+		pageSize = storageVerifyDefaultPageSize
+	}
+
+	var prevHash []byte
+	var lastHash common.Hash
+	lastSeenSnapshot := int64(-1)
+	numMiniblocks := int64(0)
+	for from := int64(0); from <= result.LastMiniblock; {
+		to := min(from+pageSize, result.LastMiniblock+1)
+		mbs, err := store.ReadMiniblocks(ctx, streamId, from, to)
+		if err != nil {
+			if riverErr := AsRiverError(err); riverErr.Code == Err_MINIBLOCKS_PRUNED && result.FirstMiniblock < 0 {
+				if first, ok := riverErr.GetTag("firstAvailableMiniblock").(int64); ok && first > from {
+					from = first
+					continue
+				}
+			}
+			result.addProblem(StorageProblemRead, from, "Failed to read miniblocks [%d, %d): %v", from, to, err)
+			return result, numMiniblocks
+		}
+		if len(mbs) != int(to-from) {
+			result.addProblem(
+				StorageProblemRead,
+				from+int64(len(mbs)),
+				"Miniblocks missing in range [%d, %d), got %d",
+				from,
+				to,
+				len(mbs),
+			)
+			return result, numMiniblocks
+		}
+		if result.FirstMiniblock < 0 {
+			result.FirstMiniblock = from
+		}
+
+		for i, data := range mbs {
+			num := from + int64(i)
+			numMiniblocks++
+
+			mb, err := NewMiniblockInfoFromBytes(data, num)
+			if err != nil {
+				result.addProblem(StorageProblemMiniblock, num, "Failed to parse miniblock: %v", err)
+				prevHash = nil
+				continue
+			}
+			header := mb.header()
+
+			if prevHash != nil && !bytes.Equal(header.PrevMiniblockHash, prevHash) {
+				result.addProblem(
+					StorageProblemPrevHash,
+					num,
+					"Previous miniblock hash mismatch, header: %x, previous miniblock: %x",
+					header.PrevMiniblockHash,
+					prevHash,
+				)
+			}
+
+			events := mb.events()
+			if len(header.EventHashes) != len(events) {
+				result.addProblem(
+					StorageProblemEventHashes,
+					num,
+					"Event count mismatch, header: %d, miniblock: %d",
+					len(header.EventHashes),
+					len(events),
+				)
+			} else {
+				for j, e := range events {
+					if !bytes.Equal(header.EventHashes[j], e.Hash[:]) {
+						result.addProblem(StorageProblemEventHashes, num, "Event hash mismatch at index %d", j)
+					}
+				}
+			}
+
+			if !mb.eventMerkleRootValid() {
+				result.addProblem(StorageProblemMerkleRoot, num, "Event Merkle root mismatch")
+			}
+
+			if mb.isSnapshot() {
+				lastSeenSnapshot = num
+			} else if num == 0 {
+				result.addProblem(StorageProblemSnapshot, num, "Genesis miniblock has no snapshot")
+			}
+
+			prevHash = mb.Ref.Hash[:]
+			lastHash = mb.Ref.Hash
+		}
+		from = to
+	}
+
+	if lastSeenSnapshot != result.LastSnapshot {
+		result.addProblem(
+			StorageProblemSnapshot,
+			result.LastSnapshot,
+			"Last snapshot recorded by storage is %d, last miniblock with snapshot is %d",
+			result.LastSnapshot,
+			lastSeenSnapshot,
+		)
+	}
+
+	if opts.Registry != nil {
+		record, err := opts.Registry.GetStream(ctx, streamId)
+		if err != nil {
+			result.addProblem(StorageProblemRegistry, -1, "Failed to read stream from registry: %v", err)
+		} else {
+			result.RegistryMiniblock = int64(record.LastMiniblockNum)
+			if result.RegistryMiniblock != result.LastMiniblock {
+				result.addProblem(
+					StorageProblemRegistry,
+					result.LastMiniblock,
+					"Last miniblock in registry is %d, in storage is %d",
+					result.RegistryMiniblock,
+					result.LastMiniblock,
+				)
+			} else if prevHash != nil && record.LastMiniblockHash != lastHash {
+				result.addProblem(
+					StorageProblemRegistry,
+					result.LastMiniblock,
+					"Last miniblock hash mismatch, registry: %s, storage: %s",
+					record.LastMiniblockHash.Hex(),
+					lastHash.Hex(),
+				)
+			}
+		}
+	}
+
+	return result, numMiniblocks
+}
@@ -0,0 +1,80 @@
+package events
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestVerifyStorage(t *testing.T) {
+	ctx, tc := makeCacheTestContext(t, testParams{replFactor: 1})
+	require := tc.require
+	tc.initCache(0, &MiniblockProducerOpts{TestDisableMbProdcutionOnBlock: true})
+	params := tc.instances[0].params
+
+	streamId, nodes, prevMb := tc.createReplStream()
+	for i := range 3 {
+		tc.addReplEvent(streamId, prevMb, nodes)
+		prevMb = tc.makeMiniblock(0, streamId, i == 1)
+	}
+	require.EqualValues(3, prevMb.Num)
+
+	opts := &StorageVerifyOpts{Registry: params.Registry, PageSize: 2}
+	report, err := VerifyStorage(ctx, params.Storage, opts)
+	require.NoError(err)
+	require.Equal(1, report.Streams)
+	require.Equal(0, report.FailedStreams)
+	require.EqualValues(4, report.Miniblocks)
+	require.Equal(&StreamVerifyResult{
+		StreamId:          streamId.String(),
+		FirstMiniblock:    0,
+		LastMiniblock:     3,
+		LastSnapshot:      2,
+		RegistryMiniblock: 3,
+	}, report.Results[0])
+
+	// Write stream that is not registered, with miniblocks 1 and 2 taken from another stream
+	// and snapshot miniblock 2 stored without the snapshot marker.
+	mbs, err := params.Storage.ReadMiniblocks(ctx, streamId, 0, 4)
+	require.NoError(err)
+	badStreamId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	genesis := MakeGenesisMiniblockForSpaceStream(t, tc.clientWallet, params.Wallet, badStreamId)
+	genesisBytes, err := proto.Marshal(genesis.Proto)
+	require.NoError(err)
+	require.NoError(params.Storage.CreateStreamStorage(ctx, badStreamId, genesisBytes))
+	require.NoError(params.Storage.WriteMiniblocks(
+		ctx,
+		badStreamId,
+		[]*storage.WriteMiniblockData{{Number: 1, Data: mbs[1]}, {Number: 2, Data: mbs[2]}},
+		3,
+		nil,
+		1,
+		0,
+	))
+
+	report, err = VerifyStorage(ctx, params.Storage, &StorageVerifyOpts{
+		Registry:   params.Registry,
+		Streams:    []StreamId{streamId, badStreamId},
+		OnlyFailed: true,
+	})
+	require.NoError(err)
+	require.Equal(2, report.Streams)
+	require.Equal(1, report.FailedStreams)
+	require.Len(report.Results, 1)
+
+	result := report.Results[0]
+	require.Equal(badStreamId.String(), result.StreamId)
+	var kinds []string
+	for _, p := range result.Problems {
+		kinds = append(kinds, p.Kind)
+	}
+	require.ElementsMatch(
+		[]string{StorageProblemPrevHash, StorageProblemSnapshot, StorageProblemRegistry},
+		kinds,
+		result.Problems,
+	)
+}
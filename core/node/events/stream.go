@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	. "github.com/river-build/river/core/node/base"
@@ -48,6 +49,8 @@ type SyncResultReceiver interface {
 	OnSyncError(err error)
 	// OnStreamSyncDown is called when updates for a stream could not be given.
 	OnStreamSyncDown(StreamId)
+	// OnStreamSyncError is called when updates for a stream won't be given anymore, e.g. since it's deleted.
+	OnStreamSyncError(StreamId, error)
 }
 
 // TODO: refactor interfaces.
@@ -95,6 +98,22 @@ type streamImpl struct {
 
 	// lastAccessedTime keeps track of when the stream was last used by a client
 	lastAccessedTime time.Time
+	// uses and lastUsed (unix nanoseconds) count requests for the stream from the cache,
+	// they are updated without the lock and order views for eviction when the cache is over its memory budget.
+	uses     atomic.Int64
+	lastUsed atomic.Int64
+	// hotTime (unix nanoseconds) is the time of the last write to or subscription on the stream.
+	// Recently hot streams are persisted and their views are preloaded on startup.
+	hotTime atomic.Int64
+	// pendingSince is the time the minipool became non-empty or the last miniblock was applied,
+	// zero if the minipool is empty.
+	// It approximates the age of the oldest pending event and is used to prioritize miniblock production.
+	pendingSince time.Time
+	// sealed is set once the stream is sealed either in the registry or in the last snapshot.
+	// Sealed streams are read-only and are skipped by miniblock production, warmup and the archiver.
+	sealed atomic.Bool
+	// deleted is set when the stream is deleted, deleted streams are never loaded again.
+	deleted atomic.Bool
 	// lastScrubbedTime keeps track of when the stream was last scrubbed. Streams that
 	// are never scrubbed will not have this value modified.
 	lastScrubbedTime time.Time
@@ -118,7 +137,19 @@ func (s *streamImpl) view() *streamViewImpl {
 }
 
 func (s *streamImpl) setView(view *streamViewImpl) {
+	prev := s.useGetterAndSetterToGetView
 	s.useGetterAndSetterToGetView = view
+	if view != nil && view.snapshot.GetMembers().GetSealed() {
+		s.sealed.Store(true)
+	}
+	// Arrival time of events is not tracked, so events left in the minipool after a miniblock is applied
+	// are considered pending since then. Otherwise minipool of a busy stream that never empties would look starved.
+	blockApplied := prev != nil && view != nil && prev.LastBlock().Ref.Num != view.LastBlock().Ref.Num
+	if view == nil || view.minipool.events.Len() == 0 {
+		s.pendingSince = time.Time{}
+	} else if s.pendingSince.IsZero() || blockApplied {
+		s.pendingSince = time.Now()
+	}
 	if view != nil && len(s.pendingCandidates) > 0 {
 		lastMbNum := view.LastBlock().Ref.Num
 		for i, candidate := range s.pendingCandidates {
@@ -151,6 +182,10 @@ func (s *streamImpl) loadInternal(ctx context.Context) error {
 		return nil
 	}
 
+	if s.deleted.Load() {
+		return streamDeletedError(s.streamId).Func("loadInternal")
+	}
+
 	streamRecencyConstraintsGenerations := int(s.params.ChainConfig.Get().RecencyConstraintsGen)
 
 	streamData, err := s.params.Storage.ReadStreamFromLastSnapshot(
@@ -165,6 +200,29 @@ func (s *streamImpl) loadInternal(ctx context.Context) error {
 		return err
 	}
 
+	return s.loadFromStorageResultNoLock(ctx, streamData)
+}
+
+// loadFromStorageResult sets the view from the data read from storage by the batched read,
+// unless the view was loaded in the meantime.
+func (s *streamImpl) loadFromStorageResult(
+	ctx context.Context,
+	streamData *storage.ReadStreamFromLastSnapshotResult,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.view() != nil {
+		return nil
+	}
+	s.lastAccessedTime = time.Now()
+	return s.loadFromStorageResultNoLock(ctx, streamData)
+}
+
+func (s *streamImpl) loadFromStorageResultNoLock(
+	ctx context.Context,
+	streamData *storage.ReadStreamFromLastSnapshotResult,
+) error {
 	view, err := MakeStreamView(ctx, streamData)
 	if err != nil {
 		dlog.FromCtx(ctx).
@@ -542,7 +600,9 @@ func (s *streamImpl) tryCleanup(expiration time.Duration) bool {
 		return true
 	}
 
-	if time.Since(s.lastAccessedTime) < expiration {
+	// Sealed streams don't change, so their views are unloaded as soon as nobody is subscribed.
+	sealedIdle := s.sealed.Load() && (s.receivers == nil || s.receivers.Cardinality() == 0)
+	if !sealedIdle && time.Since(s.lastAccessedTime) < expiration {
 		return false
 	}
 
@@ -560,7 +620,8 @@ func (s *streamImpl) tryCleanup(expiration time.Duration) bool {
 
 // Returns
 // miniblocks: with indexes from fromIndex inclusive, to toIndex exlusive
-// terminus: true if fromIndex is 0, or if there are no more blocks because they've been garbage collected
+// terminus: true if fromIndex is 0
+// Err_MINIBLOCKS_PRUNED is returned if fromIndex precedes miniblocks retained by the stream retention policy.
 func (s *streamImpl) GetMiniblocks(
 	ctx context.Context,
 	fromInclusive int64,
@@ -591,6 +652,17 @@ func (s *streamImpl) GetMiniblocks(
 func (s *streamImpl) AddEvent(ctx context.Context, event *ParsedEvent) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+
+	if s.sealed.Load() {
+		return RiverError(Err_STREAM_SEALED, "Stream is sealed").
+			Func("streamImpl.AddEvent").
+			Tag("streamId", s.streamId)
+	}
+
+	if event.Event.Ephemeral {
+		return s.addEphemeralEventNoLock(ctx, event)
+	}
+
 	if err := s.loadInternal(ctx); err != nil {
 		return err
 	}
@@ -598,6 +670,23 @@ func (s *streamImpl) AddEvent(ctx context.Context, event *ParsedEvent) error {
 	return s.addEventImpl(ctx, event)
 }
 
+// addEphemeralEventNoLock delivers the event to subscribers without writing it to storage or the minipool,
+// so it's never included in a miniblock. Subscribers get the current sync cookie with the event.
+// Lock must be taken.
+func (s *streamImpl) addEphemeralEventNoLock(ctx context.Context, event *ParsedEvent) error {
+	if s.receivers == nil || s.receivers.Cardinality() == 0 {
+		return nil
+	}
+
+	if err := s.loadInternal(ctx); err != nil {
+		return err
+	}
+
+	syncCookie := s.view().SyncCookie(s.params.Wallet.Address)
+	s.notifySubscribers([]*Envelope{event.Envelope}, syncCookie, syncCookie)
+	return nil
+}
+
 // caller must have a RW lock on s.mu
 func (s *streamImpl) notifySubscribers(envelopes []*Envelope, newSyncCookie *SyncCookie, prevSyncCookie *SyncCookie) {
 	if s.receivers != nil && s.receivers.Cardinality() > 0 {
@@ -620,6 +709,14 @@ func (s *streamImpl) addEventImpl(ctx context.Context, event *ParsedEvent) error
 		return err
 	}
 
+	// Events are validated against the view before they are forwarded to replicas,
+	// but replicas may receive them after the seal event is added.
+	if s.view().IsSealed() {
+		return RiverError(Err_STREAM_SEALED, "Stream is sealed").
+			Func("streamImpl.addEventImpl").
+			Tag("streamId", s.streamId)
+	}
+
 	// Check if event can be added before writing to storage.
 	newSV, err := s.view().copyAndAddEvent(event)
 	if err != nil {
@@ -644,6 +741,7 @@ func (s *streamImpl) addEventImpl(ctx context.Context, event *ParsedEvent) error
 	newSyncCookie := s.view().SyncCookie(s.params.Wallet.Address)
 
 	s.notifySubscribers([]*Envelope{event.Envelope}, newSyncCookie, prevSyncCookie)
+	s.hotTime.Store(time.Now().UnixNano())
 
 	return nil
 }
@@ -682,6 +780,7 @@ func (s *streamImpl) Sub(ctx context.Context, cookie *SyncCookie, receiver SyncR
 	}
 
 	s.lastAccessedTime = time.Now()
+	s.hotTime.Store(s.lastAccessedTime.UnixNano())
 
 	if cookie.MinipoolGen == s.view().minipool.generation {
 		if slot > int64(s.view().minipool.events.Len()) {
@@ -781,8 +880,9 @@ func (s *streamImpl) canCreateMiniblock() bool {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	// Loaded, has events in minipool, and periodic miniblock creation is not disabled in test settings.
-	return s.view() != nil &&
+	// Not sealed, loaded, has events in minipool, and periodic miniblock creation is not disabled in test settings.
+	return !s.sealed.Load() &&
+		s.view() != nil &&
 		s.view().minipool.events.Len() > 0 &&
 		!s.view().snapshot.GetInceptionPayload().GetSettings().GetDisableMiniblockCreation()
 }
@@ -819,6 +919,12 @@ func (s *streamImpl) SaveMiniblockCandidate(ctx context.Context, mb *Miniblock)
 	if err != nil {
 		return err
 	}
+	// Miniblock with invalid root is registered by hash, but events in it can't be proven against the registry.
+	if !mbInfo.eventMerkleRootValid() {
+		return RiverError(Err_BAD_BLOCK, "Event Merkle root mismatch").
+			Func("SaveMiniblockCandidate").
+			Tags("streamId", s.streamId, "miniblockNum", mbInfo.Ref.Num)
+	}
 
 	applied, err := s.tryApplyCandidate(ctx, mbInfo)
 	if err != nil {
@@ -895,7 +1001,13 @@ func (s *streamImpl) tryApplyCandidate(ctx context.Context, mb *MiniblockInfo) (
 func (s *streamImpl) tryReadAndApplyCandidateNoLock(ctx context.Context, mbRef *MiniblockRef) bool {
 	miniblockBytes, err := s.params.Storage.ReadMiniblockCandidate(ctx, s.streamId, mbRef.Hash, mbRef.Num)
 	if err == nil {
-		miniblock, err := NewMiniblockInfoFromBytes(miniblockBytes, mbRef.Num)
+		var miniblock *MiniblockInfo
+		miniblock, err = NewMiniblockInfoFromBytes(miniblockBytes, mbRef.Num)
+		if err == nil && !miniblock.eventMerkleRootValid() {
+			err = RiverError(Err_BAD_BLOCK, "Event Merkle root mismatch").
+				Func("tryReadAndApplyCandidateNoLock").
+				Tags("streamId", s.streamId, "miniblockNum", mbRef.Num)
+		}
 		if err == nil {
 			err = s.importMiniblocksNoLock(ctx, []*MiniblockInfo{miniblock})
 			if err == nil {
@@ -0,0 +1,320 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gammazero/workerpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/nodes"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/registries"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// AntiEntropyScanResult is the result of a single anti-entropy scan.
+type AntiEntropyScanResult struct {
+	StreamsScanned   int
+	DivergedStreams  int
+	RepairsScheduled int
+	// ForkedStreams is the number of streams where the local last miniblock has a different hash
+	// than the registry at the same height. Forks are not repaired automatically, see checkStreamReplicas.
+	ForkedStreams int
+}
+
+// antiEntropy holds the state of the anti-entropy scanner
+// that compares local streams with their replicas and the registry.
+type antiEntropy struct {
+	// mu serializes scans.
+	mu sync.Mutex
+
+	// reported contains nodes for which divergence metrics are set, by stream.
+	reported map[StreamId]map[common.Address]bool
+
+	// divergenceMiniblocks is the number of miniblocks the node is behind the registry, by stream and node.
+	divergenceMiniblocks *prometheus.GaugeVec
+	// hashMismatch is set to 1 if the node has a different last miniblock at the registry height.
+	hashMismatch *prometheus.GaugeVec
+	repairs      prometheus.Counter
+	// forkedStreams is the number of local streams forked from the registry found by the last scan.
+	// Such streams need operator attention.
+	forkedStreams prometheus.Gauge
+}
+
+func newAntiEntropy(params *StreamCacheParams) *antiEntropy {
+	labels := prometheus.Labels{
+		"chain_id": params.RiverChain.ChainId.String(),
+		"address":  params.Wallet.Address.String(),
+	}
+	return &antiEntropy{
+		reported: make(map[StreamId]map[common.Address]bool),
+		divergenceMiniblocks: params.Metrics.NewGaugeVecEx(
+			"stream_divergence_miniblocks", "Number of miniblocks the stream replica is behind the registry",
+			"chain_id", "address", "stream_id", "node",
+		).MustCurryWith(labels),
+		hashMismatch: params.Metrics.NewGaugeVecEx(
+			"stream_divergence_hash_mismatch",
+			"Set to 1 if the stream replica has a different last miniblock hash than the registry",
+			"chain_id", "address", "stream_id", "node",
+		).MustCurryWith(labels),
+		repairs: params.Metrics.NewCounterVecEx(
+			"stream_anti_entropy_repairs", "Number of stream repairs scheduled by the anti-entropy scanner",
+			"chain_id", "address",
+		).With(labels),
+		forkedStreams: params.Metrics.NewGaugeVecEx(
+			"stream_anti_entropy_forked_streams",
+			"Number of local streams with a different last miniblock than the registry that need operator attention",
+			"chain_id", "address",
+		).With(labels),
+	}
+}
+
+// runAntiEntropy periodically compares local streams with their replicas and the registry.
+func (s *streamCacheImpl) runAntiEntropy(ctx context.Context) {
+	log := dlog.FromCtx(ctx)
+
+	interval := s.params.Config.StreamReconciliation.AntiEntropyInterval
+	if interval <= 0 {
+		return
+	}
+
+	for {
+		select {
+		case <-time.After(interval):
+			if _, err := s.antiEntropyScan(ctx); err != nil && ctx.Err() == nil {
+				log.Warn("Anti-entropy scan failed", "error", err)
+			}
+		case <-ctx.Done():
+			log.Debug("stream cache anti-entropy shutdown")
+			return
+		}
+	}
+}
+
+// antiEntropyScan compares the last miniblock of each local stream with the registry and with the stream replicas.
+// If the local node is behind the registry a sync task is scheduled to repair the stream.
+// Replicas repair themselves when their own scanner runs, here they are only reported in the divergence metrics.
+// Streams are checked concurrently by a bounded number of workers.
+func (s *streamCacheImpl) antiEntropyScan(ctx context.Context) (AntiEntropyScanResult, error) {
+	s.antiEntropy.mu.Lock()
+	defer s.antiEntropy.mu.Unlock()
+
+	var result AntiEntropyScanResult
+
+	blockNum, err := s.params.RiverChain.Client.BlockNumber(ctx)
+	if err != nil {
+		return result, AsRiverError(err, Err_CANNOT_CALL_CONTRACT).Func("antiEntropyScan")
+	}
+
+	var records []*registries.GetStreamResult
+	err = s.params.Registry.ForAllStreams(
+		ctx,
+		crypto.BlockNumber(blockNum),
+		func(record *registries.GetStreamResult) bool {
+			if _, ok := s.cache.Load(record.StreamId); ok {
+				records = append(records, record)
+			}
+			return true
+		},
+	)
+	if err != nil {
+		return result, err
+	}
+
+	var resultMu sync.Mutex
+	reported := make(map[StreamId]map[common.Address]bool)
+	pool := workerpool.New(s.params.Config.StreamReconciliation.GetAntiEntropyWorkers())
+	for _, record := range records {
+		if ctx.Err() != nil {
+			break
+		}
+		pool.Submit(func() {
+			if ctx.Err() != nil {
+				return
+			}
+			check := s.checkStreamReplicas(ctx, record)
+
+			resultMu.Lock()
+			defer resultMu.Unlock()
+			result.StreamsScanned++
+			if len(check.divergedNodes) > 0 {
+				result.DivergedStreams++
+				reported[record.StreamId] = make(map[common.Address]bool, len(check.divergedNodes))
+				for _, node := range check.divergedNodes {
+					reported[record.StreamId][node] = true
+				}
+			}
+			if check.repaired {
+				result.RepairsScheduled++
+			}
+			if check.forked {
+				result.ForkedStreams++
+			}
+		})
+	}
+	pool.StopWait()
+	s.antiEntropy.forkedStreams.Set(float64(result.ForkedStreams))
+
+	// Reset metrics of streams and nodes that are not diverged anymore.
+	for streamId, nodes := range s.antiEntropy.reported {
+		for node := range nodes {
+			if !reported[streamId][node] {
+				s.antiEntropy.divergenceMiniblocks.DeleteLabelValues(streamId.String(), node.String())
+				s.antiEntropy.hashMismatch.DeleteLabelValues(streamId.String(), node.String())
+			}
+		}
+	}
+	s.antiEntropy.reported = reported
+
+	if result.DivergedStreams > 0 {
+		dlog.FromCtx(ctx).Info(
+			"Anti-entropy scan found diverged streams",
+			"streamsScanned", result.StreamsScanned,
+			"divergedStreams", result.DivergedStreams,
+			"repairsScheduled", result.RepairsScheduled,
+			"forkedStreams", result.ForkedStreams,
+		)
+	}
+
+	return result, ctx.Err()
+}
+
+// streamCheckResult is the result of the anti-entropy check of a single stream.
+type streamCheckResult struct {
+	// divergedNodes are nodes, including the local node, that are behind the registry or forked from it.
+	divergedNodes []common.Address
+	repaired      bool
+	forked        bool
+}
+
+// checkStreamReplicas compares the local and remote last miniblocks of the stream with the registry record
+// and updates divergence metrics. Nodes that can't be reached are skipped.
+//
+// If the local node is behind the registry, a sync task is scheduled to repair the stream.
+// If the local last miniblock has a different hash than the registry at the same height, the local copy is forked.
+// Sync tasks only append miniblocks after the last local one, so forks are not repaired automatically:
+// stream is reported in ForkedStreams and stream_anti_entropy_forked_streams and the local copy of the stream
+// has to be restored by the operator.
+func (s *streamCacheImpl) checkStreamReplicas(
+	ctx context.Context,
+	record *registries.GetStreamResult,
+) streamCheckResult {
+	log := dlog.FromCtx(ctx)
+	registryRef := &MiniblockRef{Hash: record.LastMiniblockHash, Num: int64(record.LastMiniblockNum)}
+	var result streamCheckResult
+
+	// report updates metrics for the node and returns true if the node has a different last miniblock
+	// than the registry at the same height.
+	report := func(node common.Address, ref *MiniblockRef) bool {
+		// Nodes ahead of the registry are not diverged, the registry record is read at the start of the scan.
+		behind := registryRef.Num - ref.Num
+		mismatch := behind == 0 && ref.Hash != registryRef.Hash
+		if behind <= 0 && !mismatch {
+			return false
+		}
+
+		result.divergedNodes = append(result.divergedNodes, node)
+
+		labels := []string{record.StreamId.String(), node.String()}
+		s.antiEntropy.divergenceMiniblocks.WithLabelValues(labels...).Set(float64(max(behind, 0)))
+		if mismatch {
+			s.antiEntropy.hashMismatch.WithLabelValues(labels...).Set(1)
+		} else {
+			s.antiEntropy.hashMismatch.DeleteLabelValues(labels...)
+		}
+
+		log.Warn(
+			"Stream replica diverged from registry",
+			"streamId", record.StreamId,
+			"node", node,
+			"nodeMiniblock", ref,
+			"registryMiniblock", registryRef,
+		)
+		return mismatch
+	}
+
+	localRef, err := s.localLastMiniblockRef(ctx, record.StreamId)
+	if err != nil {
+		log.Warn("Anti-entropy: failed to read local last miniblock", "streamId", record.StreamId, "error", err)
+	} else {
+		result.forked = report(s.params.Wallet.Address, localRef)
+		if result.forked {
+			log.Error(
+				"Anti-entropy: local stream is forked from registry and needs operator attention",
+				"streamId", record.StreamId,
+				"localMiniblock", localRef,
+				"registryMiniblock", registryRef,
+			)
+		} else if localRef.Num < registryRef.Num {
+			result.repaired = s.syncTasks.Submit(ctx, record, s)
+			if result.repaired {
+				s.antiEntropy.repairs.Inc()
+			}
+		}
+	}
+
+	timeout := s.params.Config.StreamReconciliation.GetAntiEntropyRequestTimeout()
+	streamNodes := NewStreamNodes(record.Nodes, s.params.Wallet.Address)
+	for _, node := range streamNodes.GetRemotes() {
+		ref, err := s.remoteLastMiniblockRef(ctx, node, record.StreamId, timeout)
+		if err != nil {
+			log.Debug(
+				"Anti-entropy: failed to get last miniblock from replica",
+				"streamId", record.StreamId,
+				"node", node,
+				"error", err,
+			)
+			continue
+		}
+		report(node, ref)
+	}
+
+	return result
+}
+
+// remoteLastMiniblockRef returns the last miniblock of the stream on the given node, the request is limited by timeout.
+func (s *streamCacheImpl) remoteLastMiniblockRef(
+	ctx context.Context,
+	node common.Address,
+	streamId StreamId,
+	timeout time.Duration,
+) (*MiniblockRef, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return s.params.RemoteMiniblockProvider.GetLastMbRef(ctx, node, streamId)
+}
+
+// localLastMiniblockRef returns the last miniblock of the local stream, from the loaded view if available.
+// If stream storage is not created yet, returns a reference with number -1.
+func (s *streamCacheImpl) localLastMiniblockRef(ctx context.Context, streamId StreamId) (*MiniblockRef, error) {
+	if entry, ok := s.cache.Load(streamId); ok {
+		if view := entry.(*streamImpl).tryGetView(); view != nil {
+			return view.LastBlock().Ref, nil
+		}
+	}
+
+	data, err := s.params.Storage.StreamLastMiniBlock(ctx, streamId)
+	if err != nil {
+		if IsRiverErrorCode(err, Err_NOT_FOUND) {
+			return &MiniblockRef{Num: -1}, nil
+		}
+		return nil, err
+	}
+
+	var mb Miniblock
+	if err := proto.Unmarshal(data.MiniBlockInfo, &mb); err != nil {
+		return nil, AsRiverError(err, Err_BAD_BLOCK).Func("localLastMiniblockRef")
+	}
+	if mb.Header == nil {
+		return nil, RiverError(Err_BAD_BLOCK, "Miniblock header is missing").Func("localLastMiniblockRef")
+	}
+
+	return &MiniblockRef{Hash: common.BytesToHash(mb.Header.Hash), Num: data.Number}, nil
+}
@@ -0,0 +1,93 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAntiEntropyScan(t *testing.T) {
+	ctx, tc := makeCacheTestContext(t, testParams{replFactor: 3, numInstances: 3})
+	require := tc.require
+
+	tc.initAllCaches(&MiniblockProducerOpts{TestDisableMbProdcutionOnBlock: true})
+
+	streamId, streamNodes, prevMb := tc.createReplStream()
+	tc.addReplEvent(streamId, prevMb, streamNodes)
+
+	leader := tc.instancesByAddr[streamNodes[0]]
+	stream, err := leader.cache.getStreamImpl(ctx, streamId)
+	require.NoError(err)
+	job := leader.mbProducer.trySchedule(ctx, stream)
+	require.NotNil(job)
+	require.Eventually(
+		func() bool {
+			return leader.mbProducer.testCheckDone(job)
+		},
+		10*time.Second,
+		10*time.Millisecond,
+	)
+
+	for _, n := range streamNodes {
+		require.EventuallyWithT(
+			func(tt *assert.CollectT) {
+				result, err := tc.instancesByAddr[n].cache.antiEntropyScan(ctx)
+				_ = assert.NoError(tt, err) &&
+					assert.Equal(tt, AntiEntropyScanResult{StreamsScanned: 1}, result)
+			},
+			5*time.Second,
+			10*time.Millisecond,
+		)
+	}
+
+	// Replica loses its copy of the stream.
+	replica := tc.instancesByAddr[streamNodes[1]]
+	replicaStream, err := replica.cache.getStreamImpl(ctx, streamId)
+	require.NoError(err)
+	replicaStream.mu.Lock()
+	replicaStream.setView(nil)
+	replicaStream.mu.Unlock()
+	require.NoError(replica.params.Storage.DeleteStream(ctx, streamId))
+
+	result, err := replica.cache.antiEntropyScan(ctx)
+	require.NoError(err)
+	require.Equal(AntiEntropyScanResult{StreamsScanned: 1, DivergedStreams: 1, RepairsScheduled: 1}, result)
+	require.EqualValues(
+		2,
+		testutil.ToFloat64(replica.cache.antiEntropy.divergenceMiniblocks.WithLabelValues(
+			streamId.String(), replica.params.Wallet.Address.String(),
+		)),
+	)
+
+	// Sync task restores the stream from the other replicas, divergence metrics are reset on the next scan.
+	require.EventuallyWithT(
+		func(tt *assert.CollectT) {
+			mb, err := replica.params.Storage.StreamLastMiniBlock(ctx, streamId)
+			_ = assert.NoError(tt, err) && assert.EqualValues(tt, 1, mb.Number)
+		},
+		5*time.Second,
+		10*time.Millisecond,
+	)
+	result, err = replica.cache.antiEntropyScan(ctx)
+	require.NoError(err)
+	require.Equal(AntiEntropyScanResult{StreamsScanned: 1}, result)
+	require.Zero(testutil.CollectAndCount(replica.cache.antiEntropy.divergenceMiniblocks))
+
+	// Registry has a different miniblock at the same height: all copies are forked, no repair is scheduled.
+	record, err := replica.params.Registry.GetStream(ctx, streamId)
+	require.NoError(err)
+	record.LastMiniblockHash = common.Hash{1}
+	check := replica.cache.checkStreamReplicas(ctx, record)
+	require.True(check.forked)
+	require.False(check.repaired)
+	require.ElementsMatch(streamNodes, check.divergedNodes)
+	require.EqualValues(
+		1,
+		testutil.ToFloat64(replica.cache.antiEntropy.hashMismatch.WithLabelValues(
+			streamId.String(), replica.params.Wallet.Address.String(),
+		)),
+	)
+}
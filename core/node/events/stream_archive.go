@@ -0,0 +1,365 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+const (
+	// StreamArchiveFormat identifies stream archive files.
+	StreamArchiveFormat = "river-stream-archive"
+	// StreamArchiveVersion is the version of the stream archive format written by ExportStream.
+	StreamArchiveVersion = 1
+
+	streamArchiveBatchSize = 100
+)
+
+// StreamArchive is a portable copy of a single stream: genesis miniblock, all following miniblocks
+// and the minipool. It is serialized as JSON, binary data is base64 encoded.
+type StreamArchive struct {
+	Format   string                `json:"format"`
+	Version  int                   `json:"version"`
+	Metadata StreamArchiveMetadata `json:"metadata"`
+	// Genesis is the serialized genesis miniblock.
+	Genesis []byte `json:"genesis"`
+	// Miniblocks are serialized miniblocks following genesis, in order.
+	Miniblocks []*StreamArchiveMiniblock `json:"miniblocks"`
+	// Minipool are serialized envelopes of events not yet included in miniblocks.
+	Minipool [][]byte `json:"minipool"`
+	// Checksum is the hex-encoded SHA-256 of the stream id and all binary data in the archive.
+	Checksum string `json:"checksum"`
+}
+
+type StreamArchiveMetadata struct {
+	StreamId              string    `json:"streamId"`
+	ExportedAt            time.Time `json:"exportedAt"`
+	ExportedBy            string    `json:"exportedBy,omitempty"`
+	LastMiniblock         int64     `json:"lastMiniblock"`
+	LastMiniblockHash     string    `json:"lastMiniblockHash"`
+	LastSnapshotMiniblock int64     `json:"lastSnapshotMiniblock"`
+}
+
+type StreamArchiveMiniblock struct {
+	Num  int64  `json:"num"`
+	Hash string `json:"hash"`
+	Data []byte `json:"data"`
+}
+
+// ExportStream reads the full history of the stream from storage.
+// Streams with pruned miniblocks can't be exported.
+func ExportStream(ctx context.Context, store storage.StreamStorage, streamId StreamId) (*StreamArchive, error) {
+	// Minipool is read first: miniblocks are only appended, so all miniblocks
+	// up to the one preceding the minipool can be read afterwards.
+	last, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	if err != nil {
+		return nil, AsRiverError(err).Func("ExportStream")
+	}
+	lastSnapshot := last.StartMiniblockNumber + int64(last.SnapshotMiniblockOffset)
+	lastMiniblock := last.StartMiniblockNumber + int64(len(last.Miniblocks)) - 1
+
+	archive := &StreamArchive{
+		Format:  StreamArchiveFormat,
+		Version: StreamArchiveVersion,
+		Metadata: StreamArchiveMetadata{
+			StreamId:              streamId.String(),
+			ExportedAt:            time.Now().UTC(),
+			LastMiniblock:         lastMiniblock,
+			LastSnapshotMiniblock: lastSnapshot,
+		},
+		Minipool: last.MinipoolEnvelopes,
+	}
+
+	for from := int64(0); from <= lastMiniblock; from += streamArchiveBatchSize {
+		to := min(from+streamArchiveBatchSize, lastMiniblock+1)
+		mbs, err := store.ReadMiniblocks(ctx, streamId, from, to)
+		if err != nil {
+			return nil, AsRiverError(err).Func("ExportStream")
+		}
+		if len(mbs) != int(to-from) {
+			return nil, RiverError(Err_INTERNAL, "Miniblocks are missing in storage", "from", from, "to", to).
+				Func("ExportStream").
+				Tag("streamId", streamId)
+		}
+		for i, data := range mbs {
+			num := from + int64(i)
+			mb, err := NewMiniblockInfoFromBytesWithOpts(
+				data,
+				NewMiniblockInfoFromProtoOpts{ExpectedBlockNumber: num, DontParseEvents: true},
+			)
+			if err != nil {
+				return nil, AsRiverError(err).Func("ExportStream").Tag("streamId", streamId).Tag("miniblock", num)
+			}
+			if num == 0 {
+				archive.Genesis = data
+			} else {
+				archive.Miniblocks = append(archive.Miniblocks, &StreamArchiveMiniblock{
+					Num:  num,
+					Hash: mb.Ref.Hash.Hex(),
+					Data: data,
+				})
+			}
+			archive.Metadata.LastMiniblockHash = mb.Ref.Hash.Hex()
+		}
+	}
+
+	archive.Checksum = archive.computeChecksum()
+	return archive, nil
+}
+
+func (a *StreamArchive) computeChecksum() string {
+	h := sha256.New()
+	write := func(data []byte) {
+		_ = binary.Write(h, binary.BigEndian, uint64(len(data)))
+		_, _ = h.Write(data)
+	}
+	write([]byte(a.Metadata.StreamId))
+	write(a.Genesis)
+	for _, mb := range a.Miniblocks {
+		_ = binary.Write(h, binary.BigEndian, mb.Num)
+		write(mb.Data)
+	}
+	for _, envelope := range a.Minipool {
+		write(envelope)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Write serializes the archive to w.
+func (a *StreamArchive) Write(w io.Writer) error {
+	return json.NewEncoder(w).Encode(a)
+}
+
+// ReadStreamArchive deserializes the archive from r and checks its format and version.
+// Archive content is not validated, see StreamArchive.Validate.
+func ReadStreamArchive(r io.Reader) (*StreamArchive, error) {
+	var archive StreamArchive
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return nil, AsRiverError(err, Err_INVALID_ARGUMENT).
+			Message("Failed to decode stream archive").
+			Func("ReadStreamArchive")
+	}
+	if archive.Format != StreamArchiveFormat {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Not a stream archive", "format", archive.Format).
+			Func("ReadStreamArchive")
+	}
+	if archive.Version < 1 || archive.Version > StreamArchiveVersion {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Unsupported stream archive version", "version", archive.Version).
+			Func("ReadStreamArchive")
+	}
+	return &archive, nil
+}
+
+// Validate checks the archive checksum, that genesis belongs to the archived stream,
+// that miniblocks form a valid hash chain with event hashes matching headers,
+// and that minipool events are valid. It returns the stream id and parsed miniblocks, including genesis.
+func (a *StreamArchive) Validate() (StreamId, []*MiniblockInfo, error) {
+	streamId, err := StreamIdFromString(a.Metadata.StreamId)
+	if err != nil {
+		return StreamId{}, nil, AsRiverError(err).Func("StreamArchive.Validate")
+	}
+
+	if checksum := a.computeChecksum(); checksum != a.Checksum {
+		return StreamId{}, nil, RiverError(Err_DATA_LOSS, "Stream archive checksum mismatch").
+			Func("StreamArchive.Validate").
+			Tag("expected", a.Checksum).
+			Tag("actual", checksum)
+	}
+
+	genesis, err := NewMiniblockInfoFromBytes(a.Genesis, 0)
+	if err != nil {
+		return StreamId{}, nil, AsRiverError(err).Func("StreamArchive.Validate").Tag("miniblock", 0)
+	}
+	genesisStreamId := genesis.header().GetSnapshot().GetInceptionPayload().GetStreamId()
+	if !streamId.EqualsBytes(genesisStreamId) {
+		return StreamId{}, nil, RiverError(Err_BAD_BLOCK, "Genesis miniblock belongs to another stream").
+			Func("StreamArchive.Validate").
+			Tag("streamId", streamId).
+			Tag("genesisStreamId", common.Bytes2Hex(genesisStreamId))
+	}
+
+	miniblocks := []*MiniblockInfo{genesis}
+	prev := genesis
+	for i, archived := range a.Miniblocks {
+		num := int64(i + 1)
+		mb, err := NewMiniblockInfoFromBytes(archived.Data, num)
+		if err != nil {
+			return StreamId{}, nil, AsRiverError(err).Func("StreamArchive.Validate").Tag("miniblock", num)
+		}
+		if err := validateArchivedMiniblock(prev, mb, archived); err != nil {
+			return StreamId{}, nil, AsRiverError(err).
+				Func("StreamArchive.Validate").
+				Tag("streamId", streamId).
+				Tag("miniblock", num)
+		}
+		miniblocks = append(miniblocks, mb)
+		prev = mb
+	}
+
+	if prev.Ref.Num != a.Metadata.LastMiniblock || prev.Ref.Hash.Hex() != a.Metadata.LastMiniblockHash {
+		return StreamId{}, nil, RiverError(Err_BAD_BLOCK, "Last miniblock doesn't match archive metadata").
+			Func("StreamArchive.Validate").
+			Tag("lastMiniblock", prev.Ref.Num).
+			Tag("metadataLastMiniblock", a.Metadata.LastMiniblock)
+	}
+
+	for i, data := range a.Minipool {
+		var envelope Envelope
+		if err := proto.Unmarshal(data, &envelope); err != nil {
+			return StreamId{}, nil, AsRiverError(err, Err_BAD_EVENT).
+				Message("Failed to decode minipool envelope").
+				Func("StreamArchive.Validate").
+				Tag("slot", i)
+		}
+		if _, err := ParseEvent(&envelope); err != nil {
+			return StreamId{}, nil, AsRiverError(err).Func("StreamArchive.Validate").Tag("slot", i)
+		}
+	}
+
+	return streamId, miniblocks, nil
+}
+
+func validateArchivedMiniblock(prev *MiniblockInfo, mb *MiniblockInfo, archived *StreamArchiveMiniblock) error {
+	if archived.Num != mb.Ref.Num {
+		return RiverError(Err_BAD_BLOCK, "Miniblock number mismatch", "archived", archived.Num)
+	}
+	if archived.Hash != mb.Ref.Hash.Hex() {
+		return RiverError(Err_BAD_BLOCK, "Miniblock hash mismatch", "archived", archived.Hash, "actual", mb.Ref.Hash)
+	}
+
+	header := mb.header()
+	if !bytes.Equal(header.PrevMiniblockHash, prev.Ref.Hash[:]) {
+		return RiverError(
+			Err_BAD_BLOCK,
+			"Previous miniblock hash mismatch",
+			"header", common.BytesToHash(header.PrevMiniblockHash),
+			"previous", prev.Ref.Hash,
+		)
+	}
+
+	events := mb.events()
+	if len(header.EventHashes) != len(events) {
+		return RiverError(Err_BAD_BLOCK, "Event count mismatch", "header", len(header.EventHashes), "events", len(events))
+	}
+	for i, e := range events {
+		if !bytes.Equal(header.EventHashes[i], e.Hash[:]) {
+			return RiverError(Err_BAD_BLOCK, "Event hash mismatch", "index", i)
+		}
+	}
+	if !mb.eventMerkleRootValid() {
+		return RiverError(Err_BAD_BLOCK, "Event Merkle root mismatch")
+	}
+	return nil
+}
+
+// StreamImportOpts configures ImportStream.
+type StreamImportOpts struct {
+	// Archive imports the stream into archive node storage. Minipool is not imported in this case.
+	Archive bool
+}
+
+// ImportStream validates the archive and writes the stream into storage.
+// The stream must not exist in storage.
+func ImportStream(
+	ctx context.Context,
+	store storage.StreamStorage,
+	archive *StreamArchive,
+	opts *StreamImportOpts,
+) (StreamId, error) {
+	streamId, miniblocks, err := archive.Validate()
+	if err != nil {
+		return StreamId{}, err
+	}
+
+	if opts.Archive {
+		err = importArchiveStream(ctx, store, streamId, archive)
+	} else {
+		err = importStream(ctx, store, streamId, archive, miniblocks)
+	}
+	if err != nil {
+		return StreamId{}, AsRiverError(err).Func("ImportStream").Tag("streamId", streamId)
+	}
+	return streamId, nil
+}
+
+func importStream(
+	ctx context.Context,
+	store storage.StreamStorage,
+	streamId StreamId,
+	archive *StreamArchive,
+	miniblocks []*MiniblockInfo,
+) error {
+	err := store.CreateStreamStorage(ctx, streamId, archive.Genesis)
+	if err != nil {
+		return err
+	}
+
+	for from := 1; from < len(miniblocks); from += streamArchiveBatchSize {
+		to := min(from+streamArchiveBatchSize, len(miniblocks))
+		batch := make([]*storage.WriteMiniblockData, 0, to-from)
+		for i := from; i < to; i++ {
+			batch = append(batch, miniblocks[i].asStorageMbWithData(archive.Miniblocks[i-1].Data))
+		}
+
+		// Intermediate minipools are empty, archived minipool is written with the last batch.
+		var envelopes [][]byte
+		if to == len(miniblocks) {
+			envelopes = archive.Minipool
+		}
+		err = store.WriteMiniblocks(ctx, streamId, batch, int64(to), envelopes, int64(from), 0)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(miniblocks) == 1 {
+		for i, envelope := range archive.Minipool {
+			if err = store.WriteEvent(ctx, streamId, 1, i, envelope); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func importArchiveStream(
+	ctx context.Context,
+	store storage.StreamStorage,
+	streamId StreamId,
+	archive *StreamArchive,
+) error {
+	err := store.CreateStreamArchiveStorage(ctx, streamId)
+	if err != nil {
+		return err
+	}
+
+	err = store.WriteArchiveMiniblocks(ctx, streamId, 0, [][]byte{archive.Genesis})
+	if err != nil {
+		return err
+	}
+	for from := 0; from < len(archive.Miniblocks); from += streamArchiveBatchSize {
+		to := min(from+streamArchiveBatchSize, len(archive.Miniblocks))
+		batch := make([][]byte, 0, to-from)
+		for _, mb := range archive.Miniblocks[from:to] {
+			batch = append(batch, mb.Data)
+		}
+		err = store.WriteArchiveMiniblocks(ctx, streamId, int64(from+1), batch)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package events
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/storage"
+)
+
+func TestStreamArchiveExportImport(t *testing.T) {
+	ctx, tc := makeCacheTestContext(t, testParams{replFactor: 1})
+	require := tc.require
+	tc.initCache(0, &MiniblockProducerOpts{TestDisableMbProdcutionOnBlock: true})
+	params := tc.instances[0].params
+
+	streamId, nodes, prevMb := tc.createReplStream()
+	for i := range 3 {
+		tc.addReplEvent(streamId, prevMb, nodes)
+		prevMb = tc.makeMiniblock(0, streamId, i == 1)
+	}
+	tc.addReplEvent(streamId, prevMb, nodes)
+
+	archive, err := ExportStream(ctx, params.Storage, streamId)
+	require.NoError(err)
+	require.EqualValues(3, archive.Metadata.LastMiniblock)
+	require.EqualValues(2, archive.Metadata.LastSnapshotMiniblock)
+	require.Equal(prevMb.Hash.Hex(), archive.Metadata.LastMiniblockHash)
+	require.Len(archive.Miniblocks, 3)
+	require.Len(archive.Minipool, 1)
+
+	var buf bytes.Buffer
+	require.NoError(archive.Write(&buf))
+	data := buf.Bytes()
+
+	archive, err = ReadStreamArchive(bytes.NewReader(data))
+	require.NoError(err)
+
+	expected, err := params.Storage.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.NoError(err)
+	expectedMbs, err := params.Storage.ReadMiniblocks(ctx, streamId, 0, 4)
+	require.NoError(err)
+
+	store := storage.NewMemoryStreamStore()
+	importedId, err := ImportStream(ctx, store, archive, &StreamImportOpts{})
+	require.NoError(err)
+	require.Equal(streamId, importedId)
+
+	actual, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.NoError(err)
+	require.Equal(expected, actual)
+	mbs, err := store.ReadMiniblocks(ctx, streamId, 0, 4)
+	require.NoError(err)
+	require.Equal(expectedMbs, mbs)
+
+	// Stream already exists.
+	_, err = ImportStream(ctx, store, archive, &StreamImportOpts{})
+	require.Equal(Err_ALREADY_EXISTS, AsRiverError(err).Code, err)
+
+	// Archive storage gets miniblocks only.
+	archiveStore := storage.NewMemoryStreamStore()
+	_, err = ImportStream(ctx, archiveStore, archive, &StreamImportOpts{Archive: true})
+	require.NoError(err)
+	mbs, err = archiveStore.ReadMiniblocks(ctx, streamId, 0, 4)
+	require.NoError(err)
+	require.Equal(expectedMbs, mbs)
+
+	// Corrupted data is detected by the checksum.
+	archive, err = ReadStreamArchive(bytes.NewReader(data))
+	require.NoError(err)
+	archive.Miniblocks[1].Data[len(archive.Miniblocks[1].Data)-1] ^= 1
+	_, _, err = archive.Validate()
+	require.Equal(Err_DATA_LOSS, AsRiverError(err).Code, err)
+
+	// Broken hash chain is detected even if the checksum is updated.
+	archive, err = ReadStreamArchive(bytes.NewReader(data))
+	require.NoError(err)
+	archive.Miniblocks = archive.Miniblocks[1:]
+	archive.Checksum = archive.computeChecksum()
+	_, _, err = archive.Validate()
+	require.Error(err)
+
+	_, err = ReadStreamArchive(bytes.NewReader([]byte(`{"format":"river-stream-archive","version":2}`)))
+	require.Equal(Err_INVALID_ARGUMENT, AsRiverError(err).Code, err)
+}
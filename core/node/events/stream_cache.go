@@ -21,6 +21,8 @@ import (
 	"github.com/river-build/river/core/node/storage"
 )
 
+const defaultCacheCleanupPollInterval = 30 * time.Second
+
 type StreamCacheParams struct {
 	Storage                 storage.StreamStorage
 	Wallet                  *crypto.Wallet
@@ -37,10 +39,17 @@ type StreamCacheParams struct {
 type StreamCache interface {
 	Params() *StreamCacheParams
 	GetStream(ctx context.Context, streamId StreamId) (SyncStream, error)
+	// LoadStreams loads views of given streams that are in cache but not loaded yet with a single
+	// batched storage read. Streams that can't be loaded this way are loaded on first use as usual.
+	LoadStreams(ctx context.Context, streamIds []StreamId)
 	ForceFlushAll(ctx context.Context)
 	GetLoadedViews(ctx context.Context) []StreamView
 	GetMbCandidateStreams(ctx context.Context) []*streamImpl
 	CacheCleanup(ctx context.Context, enabled bool, expiration time.Duration) CacheCleanupResult
+	// WarmupProgress returns progress of preloading hot streams on startup, or nil if warmup is not configured.
+	WarmupProgress() *CacheWarmupProgress
+	// DeleteStream deletes the local replica of the stream and records the tombstone.
+	DeleteStream(ctx context.Context, tombstone *storage.StreamTombstone) error
 }
 
 type streamCacheImpl struct {
@@ -51,12 +60,24 @@ type streamCacheImpl struct {
 	// streamImpl can be in unloaded state, in which case it will be loaded on first GetStream call.
 	cache sync.Map
 
+	// tombstones contains ids of deleted streams, they are not loaded into the cache again.
+	tombstones sync.Map
+
 	syncTasks *StreamSyncTasksProcessor
 
+	antiEntropy *antiEntropy
+
 	chainConfig crypto.OnChainConfiguration
 
+	// warmup is set on startup if the hot streams file is configured.
+	warmup *cacheWarmup
+
 	streamCacheSizeGauge     prometheus.Gauge
 	streamCacheUnloadedGauge prometheus.Gauge
+	streamCacheMemoryGauge   prometheus.Gauge
+	streamCacheRequests      *prometheus.CounterVec
+	streamCacheEvictions     prometheus.Counter
+	streamDeletions          prometheus.Counter
 }
 
 var _ StreamCache = (*streamCacheImpl)(nil)
@@ -91,15 +112,52 @@ func NewStreamCache(
 			params.RiverChain.ChainId.String(),
 			params.Wallet.Address.String(),
 		),
+		streamCacheMemoryGauge: params.Metrics.NewGaugeVecEx(
+			"stream_cache_memory_bytes", "Approximate memory used by loaded stream views, set if memory budget is configured",
+			"chain_id", "address",
+		).WithLabelValues(
+			params.RiverChain.ChainId.String(),
+			params.Wallet.Address.String(),
+		),
+		streamCacheRequests: params.Metrics.NewCounterVecEx(
+			"stream_cache_requests", "Number of stream cache requests by whether the stream view was loaded",
+			"chain_id", "address", "result",
+		).MustCurryWith(prometheus.Labels{
+			"chain_id": params.RiverChain.ChainId.String(),
+			"address":  params.Wallet.Address.String(),
+		}),
+		streamCacheEvictions: params.Metrics.NewCounterVecEx(
+			"stream_cache_evictions", "Number of stream views evicted to keep the stream cache within its memory budget",
+			"chain_id", "address",
+		).WithLabelValues(
+			params.RiverChain.ChainId.String(),
+			params.Wallet.Address.String(),
+		),
+		streamDeletions: params.Metrics.NewCounterVecEx(
+			"stream_deletions", "Number of streams deleted on this node",
+			"chain_id", "address",
+		).WithLabelValues(
+			params.RiverChain.ChainId.String(),
+			params.Wallet.Address.String(),
+		),
 		chainConfig: params.ChainConfig,
 		syncTasks:   syncTasks,
+		antiEntropy: newAntiEntropy(params),
+	}
+
+	tombstones, err := params.Storage.ReadStreamTombstones(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, tombstone := range tombstones {
+		s.tombstones.Store(tombstone.StreamId, true)
 	}
 
 	// schedule sync tasks for all streams that are local to this node.
 	// these tasks sync up the local db with the latest block in the registry.
 	var localStreamResults []*registries.GetStreamResult
 	if err := params.Registry.ForAllStreams(ctx, params.AppliedBlockNum, func(stream *registries.GetStreamResult) bool {
-		if slices.Contains(stream.Nodes, params.Wallet.Address) {
+		if slices.Contains(stream.Nodes, params.Wallet.Address) && !s.isDeleted(stream.StreamId) {
 			localStreamResults = append(localStreamResults, stream)
 		}
 		return true
@@ -118,12 +176,14 @@ func NewStreamCache(
 
 	// load local streams in-memory cache
 	for _, stream := range localStreamResults {
-		s.cache.Store(stream.StreamId, &streamImpl{
+		si := &streamImpl{
 			params:           params,
 			streamId:         stream.StreamId,
 			nodes:            NewStreamNodes(stream.Nodes, params.Wallet.Address),
 			lastAccessedTime: time.Now(),
-		})
+		}
+		si.sealed.Store(stream.IsSealed)
+		s.cache.Store(stream.StreamId, si)
 	}
 
 	err = params.Registry.OnStreamEvent(
@@ -137,13 +197,17 @@ func NewStreamCache(
 		return nil, err
 	}
 
+	s.startWarmup(ctx)
+
 	go s.runCacheCleanup(ctx)
+	go s.runRetention(ctx)
+	go s.runAntiEntropy(ctx)
 
 	return s, nil
 }
 
 func (s *streamCacheImpl) onStreamAllocated(ctx context.Context, event *river.StreamRegistryV1StreamAllocated) {
-	if slices.Contains(event.Nodes, s.params.Wallet.Address) {
+	if slices.Contains(event.Nodes, s.params.Wallet.Address) && !s.isDeleted(StreamId(event.StreamId)) {
 		stream := &streamImpl{
 			params:           s.params,
 			streamId:         StreamId(event.StreamId),
@@ -168,6 +232,9 @@ func (s *streamCacheImpl) onStreamLastMiniblockUpdated(
 	}
 
 	stream := entry.(*streamImpl)
+	if event.IsSealed {
+		defer stream.sealed.Store(true)
+	}
 
 	view, err := stream.getView(ctx)
 	if err != nil {
@@ -207,6 +274,9 @@ func (s *streamCacheImpl) runCacheCleanup(ctx context.Context) {
 		expirationEnabled := false
 		if pollInterval > 0 {
 			expirationEnabled = true
+		} else {
+			// Expiration is disabled, cleanup still runs to keep the cache within its memory budget.
+			pollInterval = defaultCacheCleanupPollInterval
 		}
 		select {
 		case <-time.After(pollInterval):
@@ -221,6 +291,10 @@ func (s *streamCacheImpl) runCacheCleanup(ctx context.Context) {
 type CacheCleanupResult struct {
 	TotalStreams    int
 	UnloadedStreams int
+	// EvictedStreams is the number of views unloaded to keep the cache within its memory budget.
+	EvictedStreams int
+	// LoadedBytes is the approximate memory used by loaded views after cleanup, 0 if memory budget is not set.
+	LoadedBytes int64
 }
 
 func (s *streamCacheImpl) CacheCleanup(ctx context.Context, enabled bool, expiration time.Duration) CacheCleanupResult {
@@ -229,19 +303,39 @@ func (s *streamCacheImpl) CacheCleanup(ctx context.Context, enabled bool, expira
 		result CacheCleanupResult
 	)
 
+	budget := s.params.Config.StreamCache.MemoryBudget
+	var loaded []*streamCacheEntry
+
 	// TODO: add data structure that supports to loop over streams that have their view loaded instead of
 	// looping over all streams.
 	s.cache.Range(func(streamID, streamVal any) bool {
 		result.TotalStreams++
+		stream := streamVal.(*streamImpl)
 		if enabled {
-			if stream := streamVal.(*streamImpl); stream.tryCleanup(expiration) {
+			if stream.tryCleanup(expiration) {
 				result.UnloadedStreams++
 				log.Debug("stream view is unloaded from cache", "streamId", stream.streamId)
+				return true
+			}
+		}
+		if budget > 0 {
+			if size := stream.loadedSize(); size > 0 {
+				loaded = append(loaded, &streamCacheEntry{
+					stream:   stream,
+					size:     size,
+					uses:     stream.uses.Load(),
+					lastUsed: stream.lastUsed.Load(),
+				})
 			}
 		}
 		return true
 	})
 
+	if budget > 0 {
+		result.EvictedStreams, result.LoadedBytes = s.evictToMemoryBudget(ctx, loaded, budget)
+		s.streamCacheMemoryGauge.Set(float64(result.LoadedBytes))
+	}
+
 	s.streamCacheSizeGauge.Set(float64(result.TotalStreams))
 	if enabled {
 		s.streamCacheUnloadedGauge.Set(float64(result.UnloadedStreams))
@@ -372,17 +466,70 @@ func (s *streamCacheImpl) GetStream(ctx context.Context, streamId StreamId) (Syn
 	if err != nil {
 		return nil, err
 	}
+	stream.markUsed()
+	if stream.tryGetView() != nil {
+		s.streamCacheRequests.WithLabelValues("hit").Inc()
+	} else {
+		s.streamCacheRequests.WithLabelValues("miss").Inc()
+	}
 	return stream, nil
 }
 
 func (s *streamCacheImpl) getStreamImpl(ctx context.Context, streamId StreamId) (*streamImpl, error) {
 	entry, _ := s.cache.Load(streamId)
 	if entry == nil {
+		if s.isDeleted(streamId) {
+			return nil, streamDeletedError(streamId).Func("getStreamImpl")
+		}
 		return s.tryLoadStreamRecord(ctx, streamId)
 	}
 	return entry.(*streamImpl), nil
 }
 
+func (s *streamCacheImpl) LoadStreams(ctx context.Context, streamIds []StreamId) {
+	var streams []*streamImpl
+	var toRead []StreamId
+	for _, streamId := range streamIds {
+		entry, _ := s.cache.Load(streamId)
+		if entry == nil {
+			continue
+		}
+		stream := entry.(*streamImpl)
+		if stream.tryGetView() == nil {
+			streams = append(streams, stream)
+			toRead = append(toRead, streamId)
+		}
+	}
+	if len(toRead) == 0 {
+		return
+	}
+
+	log := dlog.FromCtx(ctx)
+	results, err := s.params.Storage.ReadStreamsFromLastSnapshot(
+		ctx,
+		toRead,
+		int(s.params.ChainConfig.Get().RecencyConstraintsGen),
+	)
+	if err != nil {
+		log.Warn(
+			"LoadStreams: batched read failed, streams are loaded on demand",
+			"error", err,
+			"numStreams", len(toRead),
+		)
+		return
+	}
+
+	for _, stream := range streams {
+		streamData, ok := results[stream.streamId]
+		if !ok {
+			continue
+		}
+		if err := stream.loadFromStorageResult(ctx, streamData); err != nil {
+			log.Warn("LoadStreams: failed to load stream", "error", err, "streamId", stream.streamId)
+		}
+	}
+}
+
 func (s *streamCacheImpl) ForceFlushAll(ctx context.Context) {
 	s.cache.Range(func(key, value interface{}) bool {
 		stream := value.(*streamImpl)
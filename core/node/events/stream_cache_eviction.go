@@ -0,0 +1,112 @@
+package events
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/river-build/river/core/config"
+	"github.com/river-build/river/core/node/dlog"
+)
+
+// markUsed records a request for the stream from the cache.
+func (s *streamImpl) markUsed() {
+	s.uses.Add(1)
+	s.lastUsed.Store(time.Now().UnixNano())
+}
+
+// loadedSize returns the approximate memory used by the loaded view, or 0 if the view is not loaded.
+func (s *streamImpl) loadedSize() int64 {
+	s.mu.RLock()
+	view := s.view()
+	s.mu.RUnlock()
+	if view == nil {
+		return 0
+	}
+	return view.sizeBytes()
+}
+
+// tryEvict unloads the view to free memory. Views of streams with subscribers, pending candidates or
+// events in the minipool are never evicted. It returns true when the view is unloaded.
+func (s *streamImpl) tryEvict() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.view() == nil {
+		return false
+	}
+
+	if s.receivers != nil && s.receivers.Cardinality() > 0 {
+		return false
+	}
+
+	if s.view().minipool.size() != 0 {
+		return false
+	}
+
+	if len(s.pendingCandidates) != 0 {
+		return false
+	}
+
+	s.setView(nil)
+	return true
+}
+
+type streamCacheEntry struct {
+	stream   *streamImpl
+	size     int64
+	uses     int64
+	lastUsed int64
+}
+
+// evictToMemoryBudget unloads views of the given loaded streams until their total size fits into the budget.
+// Streams are evicted in the order of the configured eviction policy.
+// It returns the number of evicted views and the size of the views that remain loaded.
+func (s *streamCacheImpl) evictToMemoryBudget(
+	ctx context.Context,
+	entries []*streamCacheEntry,
+	budget int64,
+) (int, int64) {
+	var total int64
+	for _, e := range entries {
+		total += e.size
+	}
+	if total <= budget {
+		return 0, total
+	}
+
+	if s.params.Config.StreamCache.EvictionPolicy == config.StreamCacheEvictionLFU {
+		slices.SortFunc(entries, func(a, b *streamCacheEntry) int {
+			if c := cmp.Compare(a.uses, b.uses); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.lastUsed, b.lastUsed)
+		})
+	} else {
+		slices.SortFunc(entries, func(a, b *streamCacheEntry) int {
+			return cmp.Compare(a.lastUsed, b.lastUsed)
+		})
+	}
+
+	evicted := 0
+	for _, e := range entries {
+		if total <= budget {
+			break
+		}
+		if e.stream.tryEvict() {
+			evicted++
+			total -= e.size
+			s.streamCacheEvictions.Inc()
+		}
+	}
+
+	if total > budget {
+		dlog.FromCtx(ctx).Warn(
+			"Stream cache is over memory budget, remaining views can't be evicted",
+			"budget", budget,
+			"loadedBytes", total,
+		)
+	}
+	return evicted, total
+}
@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
+	"github.com/river-build/river/core/config"
 	"github.com/river-build/river/core/node/crypto"
 	"github.com/river-build/river/core/node/protocol"
 	"github.com/river-build/river/core/node/shared"
@@ -126,6 +128,53 @@ func TestStreamCacheViewEviction(t *testing.T) {
 	require.Equal(1, streamWithLoadedViewCount, "stream cache must have 1 loaded stream")
 }
 
+func TestStreamCacheLoadStreams(t *testing.T) {
+	require := require.New(t)
+	ctx, tc := makeCacheTestContext(t, testParams{})
+
+	// disable auto stream cache cleanup, do cleanup manually
+	tc.btc.SetConfigValue(t, ctx, crypto.StreamCacheExpirationPollIntervalMsConfigKey, crypto.ABIEncodeUint64(0))
+
+	streamCache := tc.initCache(0, nil)
+	node := tc.getBC()
+
+	var streamIDs []shared.StreamId
+	for range 3 {
+		streamID := testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+		_, genesisMiniblock := makeTestSpaceStream(t, node.Wallet, streamID, nil)
+		tc.createStreamNoCache(streamID, genesisMiniblock)
+
+		stream, err := streamCache.GetStream(ctx, streamID)
+		require.NoError(err)
+		_, err = stream.GetView(ctx)
+		require.NoError(err)
+		streamIDs = append(streamIDs, streamID)
+	}
+
+	time.Sleep(10 * time.Millisecond) // make sure we hit the cache expiration of 1 ms
+	ctxShort, cancelShort := context.WithTimeout(ctx, 25*time.Millisecond)
+	streamCache.CacheCleanup(ctxShort, true, time.Millisecond)
+	cancelShort()
+
+	for _, streamID := range streamIDs {
+		stream, err := streamCache.getStreamImpl(ctx, streamID)
+		require.NoError(err)
+		require.Nil(stream.tryGetView(), "view must be unloaded")
+	}
+
+	// Streams unknown to the cache are skipped.
+	streamCache.LoadStreams(ctx, append(streamIDs, testutils.FakeStreamId(shared.STREAM_SPACE_BIN)))
+
+	for _, streamID := range streamIDs {
+		stream, err := streamCache.getStreamImpl(ctx, streamID)
+		require.NoError(err)
+		view := stream.tryGetView()
+		require.NotNil(view, "view must be loaded")
+		require.Equal(streamID, *view.StreamId())
+		require.EqualValues(0, view.LastBlock().Ref.Num)
+	}
+}
+
 func TestCacheEvictionWithFilledMiniBlockPool(t *testing.T) {
 	require := require.New(t)
 	ctx, tc := makeCacheTestContext(t, testParams{})
@@ -209,6 +258,129 @@ func TestCacheEvictionWithFilledMiniBlockPool(t *testing.T) {
 	require.Nil(loadedStream.(*streamImpl).view(), "view loaded in cache")
 }
 
+func TestStreamCacheMemoryBudgetEviction(t *testing.T) {
+	require := require.New(t)
+	ctx, tc := makeCacheTestContext(t, testParams{})
+
+	// disable auto stream cache cleanup, do cleanup manually
+	tc.btc.SetConfigValue(t, ctx, crypto.StreamCacheExpirationPollIntervalMsConfigKey, crypto.ABIEncodeUint64(0))
+
+	streamCache := tc.initCache(0, nil)
+	node := tc.getBC()
+
+	var streams []SyncStream
+	for range 3 {
+		streamID := testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+		_, genesisMiniblock := makeTestSpaceStream(t, node.Wallet, streamID, nil)
+		tc.createStreamNoCache(streamID, genesisMiniblock)
+
+		stream, err := streamCache.GetStream(ctx, streamID)
+		require.NoError(err)
+		_, err = stream.GetView(ctx)
+		require.NoError(err)
+		streams = append(streams, stream)
+	}
+	a, b, c := streams[0].(*streamImpl), streams[1].(*streamImpl), streams[2].(*streamImpl)
+	require.Positive(a.loadedSize())
+
+	// Without budget nothing is evicted.
+	result := streamCache.CacheCleanup(ctx, false, 0)
+	require.Zero(result.EvictedStreams)
+
+	// LRU: a is the least recently used, but it has a subscriber, so b and c are evicted.
+	view, err := a.GetView(ctx)
+	require.NoError(err)
+	receiver := &testStreamCacheViewEvictionSub{}
+	require.NoError(a.Sub(ctx, view.SyncCookie(node.Wallet.Address), receiver))
+
+	streamCache.params.Config.StreamCache.MemoryBudget = a.loadedSize()
+	result = streamCache.CacheCleanup(ctx, false, 0)
+	require.Equal(2, result.EvictedStreams)
+	require.Equal(a.loadedSize(), result.LoadedBytes)
+	require.NotNil(a.tryGetView())
+	require.Nil(b.tryGetView())
+	require.Nil(c.tryGetView())
+	a.Unsub(receiver)
+
+	// LFU: c is used most often and is kept.
+	for _, stream := range []SyncStream{b, c, c, c} {
+		_, err := streamCache.GetStream(ctx, stream.(*streamImpl).streamId)
+		require.NoError(err)
+		_, err = stream.GetView(ctx)
+		require.NoError(err)
+	}
+	streamCache.params.Config.StreamCache.EvictionPolicy = config.StreamCacheEvictionLFU
+	streamCache.params.Config.StreamCache.MemoryBudget = c.loadedSize()
+	result = streamCache.CacheCleanup(ctx, false, 0)
+	require.Equal(2, result.EvictedStreams)
+	require.Nil(a.tryGetView())
+	require.Nil(b.tryGetView())
+	require.NotNil(c.tryGetView())
+}
+
+func TestStreamCacheWarmup(t *testing.T) {
+	require := require.New(t)
+	ctx, tc := makeCacheTestContext(t, testParams{})
+
+	// disable auto stream cache cleanup, do cleanup manually
+	tc.btc.SetConfigValue(t, ctx, crypto.StreamCacheExpirationPollIntervalMsConfigKey, crypto.ABIEncodeUint64(0))
+
+	params := tc.instances[0].params
+	params.Config.StreamCache.HotStreamsFile = filepath.Join(t.TempDir(), "hot_streams.json")
+
+	streamCache := tc.initCache(0, nil)
+	require.Eventually(func() bool { return streamCache.WarmupProgress().Done }, 10*time.Second, 10*time.Millisecond)
+	require.Zero(streamCache.WarmupProgress().Total)
+
+	node := tc.getBC()
+	var streamIDs []shared.StreamId
+	var streams []SyncStream
+	for range 3 {
+		streamID := testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+		_, genesisMiniblock := makeTestSpaceStream(t, node.Wallet, streamID, nil)
+		tc.createStreamNoCache(streamID, genesisMiniblock)
+
+		stream, err := streamCache.GetStream(ctx, streamID)
+		require.NoError(err)
+		_, err = stream.GetView(ctx)
+		require.NoError(err)
+		streamIDs = append(streamIDs, streamID)
+		streams = append(streams, stream)
+
+		if len(streams) == 1 {
+			addEventToStream(t, ctx, params, stream, "hello",
+				&MiniblockRef{Hash: common.BytesToHash(genesisMiniblock.Header.Hash), Num: 0})
+		}
+	}
+
+	// The first stream was written to, the second one is subscribed, the third one is only read.
+	view, err := streams[1].GetView(ctx)
+	require.NoError(err)
+	require.NoError(streams[1].Sub(ctx, view.SyncCookie(node.Wallet.Address), &testStreamCacheViewEvictionSub{}))
+
+	require.NoError(streamCache.persistHotStreams())
+	hot, err := readHotStreams(params.Config.StreamCache.HotStreamsFile)
+	require.NoError(err)
+	require.Equal([]shared.StreamId{streamIDs[1], streamIDs[0]}, hot)
+
+	// Restarted cache preloads hot streams.
+	blockNum, err := node.GetBlockNumber(ctx)
+	require.NoError(err)
+	params.AppliedBlockNum = blockNum
+	streamCache, err = NewStreamCache(ctx, params)
+	require.NoError(err)
+	require.Eventually(func() bool { return streamCache.WarmupProgress().Done }, 10*time.Second, 10*time.Millisecond)
+	progress := streamCache.WarmupProgress()
+	require.Equal(2, progress.Total)
+	require.Equal(2, progress.Loaded)
+
+	for i, streamID := range streamIDs {
+		entry, ok := streamCache.cache.Load(streamID)
+		require.True(ok)
+		require.Equal(i < 2, entry.(*streamImpl).tryGetView() != nil)
+	}
+}
+
 type testStreamCacheViewEvictionSub struct {
 	receivedStreamAndCookies []*protocol.StreamAndCookie
 	receivedErrors           []error
@@ -227,6 +399,11 @@ func (sub *testStreamCacheViewEvictionSub) OnStreamSyncDown(streamID shared.Stre
 	sub.streamErrors = append(sub.streamErrors, streamID)
 }
 
+func (sub *testStreamCacheViewEvictionSub) OnStreamSyncError(streamID shared.StreamId, err error) {
+	sub.streamErrors = append(sub.streamErrors, streamID)
+	sub.receivedErrors = append(sub.receivedErrors, err)
+}
+
 func (sub *testStreamCacheViewEvictionSub) eventsReceived() int {
 	count := 0
 	for _, sac := range sub.receivedStreamAndCookies {
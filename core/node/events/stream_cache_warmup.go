@@ -0,0 +1,274 @@
+package events
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+const warmupBatchSize = 100
+
+// CacheWarmupProgress reports preloading of views of streams that were hot before the restart.
+type CacheWarmupProgress struct {
+	Total     int
+	Processed int
+	Loaded    int
+	Done      bool
+	Elapsed   time.Duration
+}
+
+// hotStreamsList is the persisted list of hot streams, most recently hot first.
+type hotStreamsList struct {
+	SavedAt time.Time  `json:"savedAt"`
+	Streams []StreamId `json:"streams"`
+}
+
+type cacheWarmup struct {
+	start     time.Time
+	total     int
+	processed atomic.Int64
+	loaded    atomic.Int64
+	elapsed   atomic.Int64
+	done      atomic.Bool
+}
+
+// WarmupProgress returns progress of the cache warmup, or nil if warmup is not configured.
+func (s *streamCacheImpl) WarmupProgress() *CacheWarmupProgress {
+	w := s.warmup
+	if w == nil {
+		return nil
+	}
+	p := &CacheWarmupProgress{
+		Total:     w.total,
+		Processed: int(w.processed.Load()),
+		Loaded:    int(w.loaded.Load()),
+		Done:      w.done.Load(),
+	}
+	if p.Done {
+		p.Elapsed = time.Duration(w.elapsed.Load())
+	} else {
+		p.Elapsed = time.Since(w.start)
+	}
+	return p
+}
+
+func (s *streamImpl) hasSubscribers() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.receivers != nil && s.receivers.Cardinality() > 0
+}
+
+// hotStreams returns local streams that have subscribers or were written to or subscribed on within maxAge,
+// most recently hot first.
+func (s *streamCacheImpl) hotStreams(maxAge time.Duration, limit int) []StreamId {
+	type hotStream struct {
+		streamId StreamId
+		hotTime  int64
+	}
+
+	now := time.Now().UnixNano()
+	minHotTime := now - maxAge.Nanoseconds()
+	var hot []hotStream
+	s.cache.Range(func(key, value any) bool {
+		stream := value.(*streamImpl)
+		if stream.sealed.Load() {
+			return true
+		}
+		hotTime := stream.hotTime.Load()
+		if hotTime < minHotTime && stream.hasSubscribers() {
+			hotTime = now
+		}
+		if hotTime >= minHotTime {
+			hot = append(hot, hotStream{streamId: stream.streamId, hotTime: hotTime})
+		}
+		return true
+	})
+
+	slices.SortFunc(hot, func(a, b hotStream) int {
+		return cmp.Compare(b.hotTime, a.hotTime)
+	})
+	if len(hot) > limit {
+		hot = hot[:limit]
+	}
+
+	ret := make([]StreamId, len(hot))
+	for i, h := range hot {
+		ret[i] = h.streamId
+	}
+	return ret
+}
+
+// persistHotStreams writes the hot streams list to the configured file.
+// The file is replaced atomically, so a crash while writing leaves the previous list intact.
+func (s *streamCacheImpl) persistHotStreams() error {
+	cfg := s.params.Config.StreamCache
+	data, err := json.Marshal(&hotStreamsList{
+		SavedAt: time.Now(),
+		Streams: s.hotStreams(cfg.GetHotStreamsMaxAge(), cfg.GetHotStreamsLimit()),
+	})
+	if err != nil {
+		return AsRiverError(err, Err_INTERNAL).Func("persistHotStreams")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(cfg.HotStreamsFile), filepath.Base(cfg.HotStreamsFile)+".*.tmp")
+	if err != nil {
+		return AsRiverError(err, Err_INTERNAL).
+			Message("Failed to create hot streams file").
+			Func("persistHotStreams").
+			Tag("file", cfg.HotStreamsFile)
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), cfg.HotStreamsFile)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return AsRiverError(err, Err_INTERNAL).
+			Message("Failed to write hot streams file").
+			Func("persistHotStreams").
+			Tag("file", cfg.HotStreamsFile)
+	}
+	return nil
+}
+
+// runHotStreamsPersist periodically persists the hot streams list and persists it one last time on shutdown.
+func (s *streamCacheImpl) runHotStreamsPersist(ctx context.Context) {
+	log := dlog.FromCtx(ctx)
+	ticker := time.NewTicker(s.params.Config.StreamCache.GetHotStreamsPersistInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.persistHotStreams(); err != nil {
+				log.Warn("Failed to persist hot streams", "error", err)
+			}
+		case <-ctx.Done():
+			if err := s.persistHotStreams(); err != nil {
+				log.Warn("Failed to persist hot streams on shutdown", "error", err)
+			}
+			return
+		}
+	}
+}
+
+// readHotStreams reads the persisted hot streams list. Missing file is not an error, nil is returned.
+func readHotStreams(path string) ([]StreamId, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, AsRiverError(err, Err_INTERNAL).
+			Message("Failed to read hot streams file").
+			Func("readHotStreams").
+			Tag("file", path)
+	}
+
+	var list hotStreamsList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, AsRiverError(err, Err_BAD_CONFIG).
+			Message("Failed to parse hot streams file").
+			Func("readHotStreams").
+			Tag("file", path)
+	}
+	return list.Streams, nil
+}
+
+// startWarmup starts preloading views of the persisted hot streams in the background
+// and starts persisting the current hot streams list. It is a no-op if the hot streams file is not configured.
+func (s *streamCacheImpl) startWarmup(ctx context.Context) {
+	cfg := s.params.Config.StreamCache
+	if cfg.HotStreamsFile == "" {
+		return
+	}
+	log := dlog.FromCtx(ctx)
+
+	streamIds, err := readHotStreams(cfg.HotStreamsFile)
+	if err != nil {
+		log.Warn("Cache warmup: hot streams are not preloaded", "error", err)
+	}
+
+	// Streams that are no longer local are skipped.
+	var local []StreamId
+	for _, streamId := range streamIds {
+		if _, ok := s.cache.Load(streamId); ok {
+			local = append(local, streamId)
+		}
+	}
+
+	s.warmup = &cacheWarmup{
+		start: time.Now(),
+		total: len(local),
+	}
+	go s.runWarmup(ctx, local)
+	go s.runHotStreamsPersist(ctx)
+}
+
+func (s *streamCacheImpl) runWarmup(ctx context.Context, streamIds []StreamId) {
+	w := s.warmup
+	log := dlog.FromCtx(ctx)
+	defer func() {
+		w.elapsed.Store(int64(time.Since(w.start)))
+		w.done.Store(true)
+		log.Info(
+			"Cache warmup finished",
+			"total", w.total,
+			"processed", w.processed.Load(),
+			"loaded", w.loaded.Load(),
+			"elapsed", time.Since(w.start),
+		)
+	}()
+	if len(streamIds) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.params.Config.StreamCache.GetWarmupTimeout())
+	defer cancel()
+
+	batches := make(chan []StreamId)
+	var wg sync.WaitGroup
+	for range s.params.Config.StreamCache.GetWarmupConcurrency() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				s.LoadStreams(ctx, batch)
+				for _, streamId := range batch {
+					if entry, ok := s.cache.Load(streamId); ok && entry.(*streamImpl).tryGetView() != nil {
+						w.loaded.Add(1)
+					}
+				}
+				w.processed.Add(int64(len(batch)))
+			}
+		}()
+	}
+
+sendLoop:
+	for i := 0; i < len(streamIds); i += warmupBatchSize {
+		select {
+		case batches <- streamIds[i:min(i+warmupBatchSize, len(streamIds))]:
+		case <-ctx.Done():
+			log.Warn("Cache warmup timed out, remaining streams are loaded on demand")
+			break sendLoop
+		}
+	}
+	close(batches)
+	wg.Wait()
+}
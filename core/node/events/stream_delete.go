@@ -0,0 +1,72 @@
+package events
+
+import (
+	"context"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+func streamDeletedError(streamId StreamId) *RiverErrorImpl {
+	return RiverError(Err_STREAM_DELETED, "Stream is deleted").Tag("streamId", streamId)
+}
+
+// markDeleted unloads the view, prevents the stream from being loaded again and returns its subscribers.
+func (s *streamImpl) markDeleted() []SyncResultReceiver {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deleted.Store(true)
+	s.setView(nil)
+	s.pendingCandidates = nil
+
+	var receivers []SyncResultReceiver
+	if s.receivers != nil {
+		receivers = s.receivers.ToSlice()
+	}
+	s.receivers = nil
+	return receivers
+}
+
+// DeleteStream records the tombstone, removes the stream from the cache, cancels its sync subscriptions
+// with STREAM_DELETED and deletes its data from storage.
+// Deletion is idempotent: it's not an error to delete a stream that is already deleted or not in storage.
+func (s *streamCacheImpl) DeleteStream(ctx context.Context, tombstone *storage.StreamTombstone) error {
+	if err := s.params.Storage.WriteStreamTombstone(ctx, tombstone); err != nil {
+		return err
+	}
+	s.tombstones.Store(tombstone.StreamId, true)
+
+	if entry, loaded := s.cache.LoadAndDelete(tombstone.StreamId); loaded {
+		// Subscribers are notified out of the stream lock since they may call Unsub.
+		err := streamDeletedError(tombstone.StreamId)
+		for _, receiver := range entry.(*streamImpl).markDeleted() {
+			receiver.OnStreamSyncError(tombstone.StreamId, err)
+		}
+	}
+
+	err := s.params.Storage.DeleteStream(ctx, tombstone.StreamId)
+	if err != nil && !IsRiverErrorCode(err, Err_NOT_FOUND) {
+		return err
+	}
+
+	s.streamDeletions.Inc()
+	dlog.FromCtx(ctx).Info(
+		"Stream deleted",
+		"audit", true,
+		"streamId", tombstone.StreamId,
+		"requester", tombstone.Requester,
+		"reason", tombstone.Reason,
+		"deletedAt", tombstone.DeletedAt,
+	)
+	return nil
+}
+
+// isDeleted returns true if the stream has a tombstone.
+func (s *streamCacheImpl) isDeleted(streamId StreamId) bool {
+	_, ok := s.tombstones.Load(streamId)
+	return ok
+}
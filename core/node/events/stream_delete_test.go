@@ -0,0 +1,53 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestStreamCacheDeleteStream(t *testing.T) {
+	require := require.New(t)
+	ctx, tc := makeCacheTestContext(t, testParams{})
+
+	streamCache := tc.initCache(0, nil)
+	node := tc.getBC()
+
+	streamID := testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+	_, genesisMiniblock := makeTestSpaceStream(t, node.Wallet, streamID, nil)
+	tc.createStreamNoCache(streamID, genesisMiniblock)
+
+	stream, err := streamCache.GetStream(ctx, streamID)
+	require.NoError(err)
+	view, err := stream.GetView(ctx)
+	require.NoError(err)
+	receiver := &testStreamCacheViewEvictionSub{}
+	require.NoError(stream.Sub(ctx, view.SyncCookie(node.Wallet.Address), receiver))
+
+	tombstone := &storage.StreamTombstone{
+		StreamId:  streamID,
+		DeletedAt: time.Now(),
+		Requester: node.Wallet.Address,
+		Reason:    "test",
+	}
+	require.NoError(streamCache.DeleteStream(ctx, tombstone))
+
+	require.Equal([]shared.StreamId{streamID}, receiver.streamErrors)
+	require.Len(receiver.receivedErrors, 1)
+	require.Equal(protocol.Err_STREAM_DELETED, AsRiverError(receiver.receivedErrors[0]).Code)
+
+	_, err = streamCache.GetStream(ctx, streamID)
+	require.Equal(protocol.Err_STREAM_DELETED, AsRiverError(err).Code)
+	_, err = stream.GetView(ctx)
+	require.Equal(protocol.Err_STREAM_DELETED, AsRiverError(err).Code)
+
+	// Deletion is idempotent.
+	require.NoError(streamCache.DeleteStream(ctx, tombstone))
+}
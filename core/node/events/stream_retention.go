@@ -0,0 +1,58 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// retentionDisabledPollInterval is how often on-chain settings are re-checked when retention polling is disabled.
+const retentionDisabledPollInterval = time.Minute
+
+// runRetention periodically prunes miniblocks of local streams according to the on-chain retention settings.
+func (s *streamCacheImpl) runRetention(ctx context.Context) {
+	log := dlog.FromCtx(ctx)
+
+	for {
+		pollInterval := s.params.ChainConfig.Get().Retention.PollInterval
+		enabled := pollInterval > 0
+		if !enabled {
+			pollInterval = retentionDisabledPollInterval
+		}
+		select {
+		case <-time.After(pollInterval):
+			if enabled {
+				s.pruneStreams(ctx)
+			}
+		case <-ctx.Done():
+			log.Debug("stream cache retention shutdown")
+			return
+		}
+	}
+}
+
+// pruneStreams removes miniblocks of local streams that precede the N-th most recent snapshot,
+// where N is set on-chain per stream type.
+func (s *streamCacheImpl) pruneStreams(ctx context.Context) {
+	log := dlog.FromCtx(ctx)
+	retention := s.params.ChainConfig.Get().Retention
+
+	s.cache.Range(func(key, _ any) bool {
+		streamId := key.(StreamId)
+		retainSnapshots := retention.RetainSnapshotsForType(streamId.Type())
+		if retainSnapshots == 0 {
+			return true
+		}
+
+		_, err := s.params.Storage.PruneMiniblocks(ctx, streamId, int(retainSnapshots))
+		// Storage for the stream may not be created yet if stream is still being reconciled.
+		if err != nil && !IsRiverErrorCode(err, Err_NOT_FOUND) && ctx.Err() == nil {
+			log.Warn("Failed to prune stream miniblocks", "streamId", streamId, "error", err)
+		}
+		return ctx.Err() == nil
+	})
+}
@@ -0,0 +1,62 @@
+package events
+
+import (
+	"testing"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestStreamRetention(t *testing.T) {
+	ctx, tc := makeCacheTestContext(t, testParams{replFactor: 1})
+	require := tc.require
+
+	tc.btc.SetConfigValue(t, ctx, crypto.StreamRetainSnapshotsUserSettingsConfigKey, crypto.ABIEncodeUint64(2))
+
+	cache := tc.initCache(0, &MiniblockProducerOpts{TestDisableMbProdcutionOnBlock: true})
+
+	// Space streams are not pruned by default.
+	spaceStreamId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	spaceGenesis := MakeGenesisMiniblockForSpaceStream(t, tc.clientWallet, tc.instances[0].params.Wallet, spaceStreamId)
+	spaceStream, spaceView := tc.createStream(spaceStreamId, spaceGenesis.Proto)
+	addEventToStream(t, ctx, tc.instances[0].params, spaceStream, "1", spaceView.LastBlock().Ref)
+	tc.makeMiniblock(0, spaceStreamId, true)
+
+	// Every miniblock of the settings stream is a snapshot: 0 (genesis), 1, 2, 3, 4.
+	streamId, nodes, prevMb := tc.createReplStream()
+	for range 4 {
+		tc.addReplEvent(streamId, prevMb, nodes)
+		prevMb = tc.makeMiniblock(0, streamId, true)
+	}
+	require.EqualValues(4, prevMb.Num)
+
+	cache.pruneStreams(ctx)
+
+	stream, err := cache.GetStream(ctx, streamId)
+	require.NoError(err)
+
+	_, _, err = stream.GetMiniblocks(ctx, 0, 5)
+	require.Equal(Err_MINIBLOCKS_PRUNED, AsRiverError(err).Code, err)
+	_, _, err = stream.GetMiniblocks(ctx, 2, 5)
+	require.Equal(Err_MINIBLOCKS_PRUNED, AsRiverError(err).Code, err)
+
+	mbs, _, err := stream.GetMiniblocks(ctx, 3, 5)
+	require.NoError(err)
+	require.Len(mbs, 2)
+	mb, err := NewMiniblockInfoFromProto(mbs[0], NewMiniblockInfoFromProtoOpts{ExpectedBlockNumber: 3})
+	require.NoError(err)
+	require.EqualValues(3, mb.Ref.Num)
+
+	// Stream is still loaded from storage after its view is dropped.
+	require.True(stream.(*streamImpl).tryCleanup(0))
+	view, err := stream.GetView(ctx)
+	require.NoError(err)
+	require.EqualValues(4, view.LastBlock().Ref.Num)
+
+	spaceMbs, _, err := spaceStream.GetMiniblocks(ctx, 0, 2)
+	require.NoError(err)
+	require.Len(spaceMbs, 2)
+}
@@ -0,0 +1,41 @@
+package events
+
+// isSealEvent returns true if the event permanently seals the stream.
+func isSealEvent(e *ParsedEvent) bool {
+	return e.Event.GetMemberPayload().GetSeal() != nil
+}
+
+// IsSealed returns true if the stream is sealed in the last snapshot or a seal event is added since.
+// Sealed streams don't accept new events.
+func (r *streamViewImpl) IsSealed() bool {
+	return r.sealed
+}
+
+// scanSealed computes sealed flag of the view from the snapshot and events since.
+// It's used when view is loaded, views derived from it update the flag incrementally.
+func (r *streamViewImpl) scanSealed() bool {
+	if r.snapshot.GetMembers().GetSealed() {
+		return true
+	}
+	sealed := false
+	_ = r.forEachEvent(r.snapshotIndex+1, func(e *ParsedEvent, minibockNum int64, eventNum int64) (bool, error) {
+		sealed = sealed || isSealEvent(e)
+		return !sealed, nil
+	})
+	return sealed
+}
+
+// minipoolHasSealEvent returns true if the next miniblock seals the stream.
+func (r *streamViewImpl) minipoolHasSealEvent() bool {
+	sealed := false
+	_ = r.minipool.forEachEvent(func(e *ParsedEvent, minibockNum int64, eventNum int64) (bool, error) {
+		sealed = sealed || isSealEvent(e)
+		return !sealed, nil
+	})
+	return sealed
+}
+
+// isSealed returns true if the miniblock snapshot seals the stream.
+func (b *MiniblockInfo) isSealed() bool {
+	return b.header().GetSnapshot().GetMembers().GetSealed()
+}
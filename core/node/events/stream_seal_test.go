@@ -0,0 +1,80 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestSealStreamView(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+	userWallet, _ := crypto.NewWallet(ctx)
+	nodeWallet, _ := crypto.NewWallet(ctx)
+	user, err := AddressHex(userWallet.Address.Bytes())
+	require.NoError(err)
+	spaceStreamId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	channelStreamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	cfg := crypto.DefaultOnChainSettings()
+
+	_, genesisMb := makeTestChannelStream(t, userWallet, user, channelStreamId, spaceStreamId, nil)
+	genesisBytes, err := proto.Marshal(genesisMb)
+	require.NoError(err)
+	view, err := MakeStreamView(ctx, &storage.ReadStreamFromLastSnapshotResult{Miniblocks: [][]byte{genesisBytes}})
+	require.NoError(err)
+	require.False(view.IsSealed())
+	genesisRef := view.LastBlock().Ref
+
+	makeEvent := func() *ParsedEvent {
+		return MakeEvent(t, userWallet, Make_ChannelPayload_Message("hello"), genesisRef)
+	}
+
+	// Channel member seals the channel, entitlements are checked by the rules, not by the view.
+	seal := MakeEvent(t, userWallet, Make_MemberPayload_Seal("channel deleted"), genesisRef)
+	require.NoError(view.ValidateNextEvent(ctx, cfg, seal, time.Now()))
+	view, err = view.copyAndAddEvent(seal)
+	require.NoError(err)
+	require.True(view.IsSealed())
+
+	// Seal in the minipool is found when view is loaded from storage.
+	sealBytes, err := proto.Marshal(seal.Envelope)
+	require.NoError(err)
+	loaded, err := MakeStreamView(ctx, &storage.ReadStreamFromLastSnapshotResult{
+		Miniblocks:        [][]byte{genesisBytes},
+		MinipoolEnvelopes: [][]byte{sealBytes},
+	})
+	require.NoError(err)
+	require.True(loaded.IsSealed())
+
+	err = view.ValidateNextEvent(ctx, cfg, makeEvent(), time.Now())
+	require.Equal(Err_STREAM_SEALED, AsRiverError(err).Code)
+
+	// Seal forces a snapshot in the next miniblock.
+	proposal, err := view.ProposeNextMiniblock(ctx, cfg, false)
+	require.NoError(err)
+	require.True(proposal.ShouldSnapshot)
+	header, mbEvents, err := view.makeMiniblockHeader(ctx, proposal)
+	require.NoError(err)
+	mb, err := NewMiniblockInfoFromHeaderAndParsed(nodeWallet, header, mbEvents)
+	require.NoError(err)
+	require.True(mb.isSealed())
+
+	view, _, err = view.copyAndApplyBlock(mb, cfg)
+	require.NoError(err)
+	require.True(view.IsSealed())
+	require.True(view.snapshot.GetMembers().GetSealed())
+
+	err = view.ValidateNextEvent(ctx, cfg, makeEvent(), time.Now())
+	require.Equal(Err_STREAM_SEALED, AsRiverError(err).Code)
+}
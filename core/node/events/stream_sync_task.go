@@ -51,7 +51,7 @@ func (sst *StreamSyncTasksProcessor) Submit(
 	_, alreadyScheduled := sst.pendingTasks.LoadOrStore(stream.StreamId, task)
 	if !alreadyScheduled {
 		sst.workerPool.Submit(func() {
-			sst.pendingTasks.Delete(task.stream)
+			sst.pendingTasks.Delete(task.stream.StreamId)
 			task.process()
 		})
 	}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
 	"github.com/river-build/river/core/node/crypto"
 	. "github.com/river-build/river/core/node/protocol"
 	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
 	"github.com/river-build/river/core/node/testutils"
 
 	"github.com/stretchr/testify/require"
@@ -276,6 +280,49 @@ func TestCandidatePromotionCandidateInPlace(t *testing.T) {
 	require.Equal(0, view.minipool.events.Len())
 }
 
+func TestCandidateWithInvalidEventMerkleRoot(t *testing.T) {
+	ctx, tt := makeCacheTestContext(t, testParams{replFactor: 1})
+	_ = tt.initCache(0, &MiniblockProducerOpts{TestDisableMbProdcutionOnBlock: true})
+	require := require.New(t)
+	params := tt.instances[0].params
+
+	spaceStreamId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	genesisMb := MakeGenesisMiniblockForSpaceStream(t, params.Wallet, params.Wallet, spaceStreamId)
+
+	syncStream, view := tt.createStream(spaceStreamId, genesisMb.Proto)
+	stream := syncStream.(*streamImpl)
+	addEventToStream(t, ctx, params, stream, "1", view.LastBlock().Ref)
+
+	proposal, err := mbProduceCandiate_Make(ctx, params, getView(t, ctx, stream), false, stream.nodes.GetRemotes())
+	require.NoError(err)
+
+	// Signed miniblock with a root that doesn't match event hashes.
+	header := proto.Clone(proposal.header()).(*MiniblockHeader)
+	header.EventMerkleRoot = make([]byte, len(header.EventMerkleRoot))
+	bad, err := NewMiniblockInfoFromHeaderAndParsed(params.Wallet, header, proposal.events())
+	require.NoError(err)
+
+	err = stream.SaveMiniblockCandidate(ctx, bad.Proto)
+	require.Equal(Err_BAD_BLOCK, AsRiverError(err).Code)
+	_, err = params.Storage.ReadMiniblockCandidate(ctx, spaceStreamId, bad.Ref.Hash, bad.Ref.Num)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+
+	// Candidate that is already in storage is not applied either.
+	badBytes, err := bad.ToBytes()
+	require.NoError(err)
+	require.NoError(params.Storage.WriteMiniblockCandidate(ctx, spaceStreamId, bad.Ref.Hash, bad.Ref.Num, badBytes))
+	stream.mu.Lock()
+	applied := stream.tryReadAndApplyCandidateNoLock(ctx, bad.Ref)
+	stream.mu.Unlock()
+	require.False(applied)
+	require.EqualValues(0, getView(t, ctx, stream).LastBlock().Ref.Num)
+
+	// Valid candidate is applied.
+	require.NoError(stream.SaveMiniblockCandidate(ctx, proposal.Proto))
+	require.NoError(stream.promoteCandidate(ctx, proposal.Ref))
+	require.EqualValues(proposal.Ref, getView(t, ctx, stream).LastBlock().Ref)
+}
+
 func TestCandidatePromotionCandidateIsDelayed(t *testing.T) {
 	ctx, tt := makeCacheTestContext(t, testParams{replFactor: 1})
 	_ = tt.initCache(0, &MiniblockProducerOpts{TestDisableMbProdcutionOnBlock: true})
@@ -377,3 +424,121 @@ func TestCandidatePromotionCandidateIsDelayed(t *testing.T) {
 		require.Equal(int64(i*3+4), view.LastBlock().Ref.Num)
 	}
 }
+
+func TestAddEphemeralEvent(t *testing.T) {
+	ctx, tc := makeCacheTestContext(t, testParams{})
+	require := require.New(t)
+	streamCache := tc.initCache(0, &MiniblockProducerOpts{TestDisableMbProdcutionOnBlock: true})
+	params := tc.instances[0].params
+
+	var streamId StreamId
+	for id := range tc.allocateStreams(1) {
+		streamId = id
+	}
+	stream, err := streamCache.GetStream(ctx, streamId)
+	require.NoError(err)
+	view := getView(t, ctx, stream.(*streamImpl))
+
+	makeEphemeral := func(data string) *ParsedEvent {
+		streamEvent, err := MakeStreamEvent(
+			params.Wallet,
+			Make_MemberPayload_Username(&EncryptedData{Ciphertext: data}),
+			view.LastBlock().Ref,
+		)
+		require.NoError(err)
+		streamEvent.Ephemeral = true
+		envelope, err := MakeEnvelopeWithEvent(params.Wallet, streamEvent)
+		require.NoError(err)
+		return parsedEvent(t, envelope)
+	}
+
+	// Without subscribers ephemeral event is dropped.
+	require.NoError(stream.AddEvent(ctx, makeEphemeral("typing")))
+
+	sub := &testStreamCacheViewEvictionSub{}
+	require.NoError(stream.Sub(ctx, view.SyncCookie(params.Wallet.Address), sub))
+	require.Len(sub.receivedStreamAndCookies, 1)
+
+	event := makeEphemeral("typing")
+	require.NoError(stream.AddEvent(ctx, event))
+	require.Len(sub.receivedStreamAndCookies, 2)
+	update := sub.receivedStreamAndCookies[1]
+	require.Len(update.Events, 1)
+	require.Equal(event.Envelope.Hash, update.Events[0].Hash)
+	require.True(proto.Equal(view.SyncCookie(params.Wallet.Address), update.NextSyncCookie))
+
+	// Ephemeral events are not added to the minipool or storage and don't trigger miniblock production.
+	require.Empty(getView(t, ctx, stream.(*streamImpl)).MinipoolEnvelopes())
+	stored, err := params.Storage.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.NoError(err)
+	require.Empty(stored.MinipoolEnvelopes)
+	require.Empty(tc.instances[0].mbProducer.scheduleCandidates(ctx))
+
+	// Regular events still go to the minipool.
+	addEventToStream(t, ctx, params, stream, "name", view.LastBlock().Ref)
+	require.Len(getView(t, ctx, stream.(*streamImpl)).MinipoolEnvelopes(), 1)
+	require.Len(sub.receivedStreamAndCookies, 3)
+}
+
+func TestPendingSinceResetOnBlockApply(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+	userWallet, _ := crypto.NewWallet(ctx)
+	nodeWallet, _ := crypto.NewWallet(ctx)
+	streamId := UserSettingStreamIdFromAddr(userWallet.Address)
+	cfg := crypto.DefaultOnChainSettings()
+
+	genesis := MakeGenesisMiniblockForUserSettingsStream(t, userWallet, nodeWallet, streamId)
+	view, err := MakeStreamView(ctx, &storage.ReadStreamFromLastSnapshotResult{Miniblocks: [][]byte{toBytes(t, genesis)}})
+	require.NoError(err)
+	stream := &streamImpl{}
+	stream.setView(view)
+	require.True(stream.pendingSince.IsZero())
+
+	addEvent := func() *ParsedEvent {
+		event := MakeEvent(
+			t,
+			userWallet,
+			Make_UserSettingsPayload_FullyReadMarkers(&UserSettingsPayload_FullyReadMarkers{}),
+			view.LastBlock().Ref,
+		)
+		view, err = view.copyAndAddEvent(event)
+		require.NoError(err)
+		stream.setView(view)
+		return event
+	}
+	// applyBlock applies miniblock with the given event only, other events stay in the minipool.
+	applyBlock := func(event *ParsedEvent) {
+		header, events, err := view.makeMiniblockHeader(ctx, &MiniblockProposal{
+			Hashes:            [][]byte{event.Hash[:]},
+			NewMiniblockNum:   view.minipool.generation,
+			PrevMiniblockHash: view.LastBlock().Ref.Hash[:],
+		})
+		require.NoError(err)
+		mb, err := NewMiniblockInfoFromHeaderAndParsed(nodeWallet, header, events)
+		require.NoError(err)
+		view, _, err = view.copyAndApplyBlock(mb, cfg)
+		require.NoError(err)
+		stream.setView(view)
+	}
+
+	first := addEvent()
+	pendingSince := stream.pendingSince
+	require.False(pendingSince.IsZero())
+
+	// Adding events to non-empty minipool doesn't change the time.
+	time.Sleep(5 * time.Millisecond)
+	second := addEvent()
+	require.Equal(pendingSince, stream.pendingSince)
+
+	// Events stay pending across two applied miniblocks, each miniblock resets the time.
+	for _, event := range []*ParsedEvent{first, second} {
+		time.Sleep(5 * time.Millisecond)
+		addEvent()
+		applyBlock(event)
+		require.Equal(2, view.minipool.events.Len())
+		require.True(stream.pendingSince.After(pendingSince))
+		pendingSince = stream.pendingSince
+	}
+}
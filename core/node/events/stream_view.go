@@ -49,6 +49,7 @@ type StreamView interface {
 		forceSnapshot bool,
 	) (*MiniblockProposal, error)
 	IsMember(userAddress []byte) (bool, error)
+	IsSealed() bool
 }
 
 func MakeStreamView(
@@ -113,13 +114,15 @@ func MakeStreamView(
 		len(lastBlockHeader.EventHashes),
 	) + 1 // plus one for header
 
-	return &streamViewImpl{
+	view := &streamViewImpl{
 		streamId:      streamId,
 		blocks:        miniblocks,
 		minipool:      newMiniPoolInstance(minipoolEvents, generation, eventNumOffset),
 		snapshot:      snapshot,
 		snapshotIndex: snapshotIndex,
-	}, nil
+	}
+	view.sealed = view.scanSealed()
+	return view, nil
 }
 
 func MakeRemoteStreamView(ctx context.Context, resp *GetStreamResponse) (*streamViewImpl, error) {
@@ -176,13 +179,15 @@ func MakeRemoteStreamView(ctx context.Context, resp *GetStreamResponse) (*stream
 		len(lastBlockHeader.EventHashes),
 	) + 1 // plus one for header
 
-	return &streamViewImpl{
+	view := &streamViewImpl{
 		streamId:      streamId,
 		blocks:        miniblocks,
 		minipool:      newMiniPoolInstance(minipoolEvents, generation, eventNumOffset),
 		snapshot:      snapshot,
 		snapshotIndex: snapshotIndex,
-	}, nil
+	}
+	view.sealed = view.scanSealed()
+	return view, nil
 }
 
 type streamViewImpl struct {
@@ -191,6 +196,8 @@ type streamViewImpl struct {
 	minipool      *minipoolInstance
 	snapshot      *Snapshot
 	snapshotIndex int
+	// sealed is set if the stream is sealed in the snapshot or by an event since.
+	sealed bool
 }
 
 var _ StreamView = (*streamViewImpl)(nil)
@@ -215,6 +222,7 @@ func (r *streamViewImpl) copyAndAddEvent(event *ParsedEvent) (*streamViewImpl, e
 		minipool:      newMinipool,
 		snapshot:      r.snapshot,
 		snapshotIndex: r.snapshotIndex,
+		sealed:        r.sealed || isSealEvent(event),
 	}
 	return ret, nil
 }
@@ -239,7 +247,8 @@ func (r *streamViewImpl) ProposeNextMiniblock(
 		Hashes:            hashes,
 		NewMiniblockNum:   r.minipool.generation,
 		PrevMiniblockHash: r.LastBlock().headerEvent.Hash[:],
-		ShouldSnapshot:    forceSnapshot || r.shouldSnapshot(ctx, cfg),
+		// Seal is recorded in the snapshot so that sealed streams can be recognized without scanning blocks.
+		ShouldSnapshot: forceSnapshot || r.minipoolHasSealEvent() || r.shouldSnapshot(ctx, cfg),
 	}, nil
 }
 
@@ -324,6 +333,7 @@ func (r *streamViewImpl) makeMiniblockHeader(
 		Snapshot:                 snapshot,
 		EventNumOffset:           eventNumOffset,
 		PrevSnapshotMiniblockNum: miniblockNumOfPrevSnapshot,
+		EventMerkleRoot:          crypto.EventMerkleRoot(hashes),
 		Content: &MiniblockHeader_None{
 			None: &emptypb.Empty{},
 		},
@@ -377,12 +387,15 @@ func (r *streamViewImpl) copyAndApplyBlock(
 		remaining[k] = v
 	}
 
+	// Events from the minipool are already accounted for in r.sealed.
+	sealed := r.sealed || miniblock.isSealed()
 	newEvents := []*Envelope{}
 	for _, e := range miniblock.events() {
 		if _, ok := remaining[e.Hash]; ok {
 			delete(remaining, e.Hash)
 		} else {
 			newEvents = append(newEvents, e.Envelope)
+			sealed = sealed || isSealEvent(e)
 		}
 	}
 
@@ -417,6 +430,7 @@ func (r *streamViewImpl) copyAndApplyBlock(
 		minipool:      newMiniPoolInstance(minipoolEvents, generation, eventNumOffset),
 		snapshot:      snapshot,
 		snapshotIndex: snapshotIndex,
+		sealed:        sealed,
 	}, newEvents, nil
 }
 
@@ -535,13 +549,35 @@ func (r *streamViewImpl) SyncCookie(localNodeAddress common.Address) *SyncCookie
 	}
 }
 
+// shouldSnapshot returns true if the next miniblock should include a snapshot:
+// if the number or the size of the events since the last snapshot reach configured thresholds,
+// or if the last snapshot is older than the configured maximum age and there are new events since then.
 func (r *streamViewImpl) shouldSnapshot(ctx context.Context, cfg *crypto.OnChainSettings) bool {
-	minEventsPerSnapshot := int(cfg.MinSnapshotEvents.ForType(r.streamId.Type()))
+	streamType := r.streamId.Type()
+	minEventsPerSnapshot := int(cfg.MinSnapshotEvents.ForType(streamType))
+	maxBytesPerSnapshot := int(cfg.SnapshotTriggers.MaxBytesForType(streamType))
+	maxSnapshotAge := cfg.SnapshotTriggers.MaxAgeForType(streamType)
 
 	count := 0
+	size := 0
+	addEvents := func(events []*ParsedEvent) bool {
+		count += len(events)
+		if count >= minEventsPerSnapshot {
+			return true
+		}
+		if maxBytesPerSnapshot > 0 {
+			for _, e := range events {
+				size += proto.Size(e.Envelope)
+			}
+			if size >= maxBytesPerSnapshot {
+				return true
+			}
+		}
+		return false
+	}
+
 	// count the events in the minipool
-	count += r.minipool.events.Len()
-	if count >= minEventsPerSnapshot {
+	if addEvents(r.minipool.events.Values) {
 		return true
 	}
 	// count the events in blocks since the last snapshot
@@ -550,8 +586,14 @@ func (r *streamViewImpl) shouldSnapshot(ctx context.Context, cfg *crypto.OnChain
 		if block.header().Snapshot != nil {
 			break
 		}
-		count += len(block.events())
-		if count >= minEventsPerSnapshot {
+		if addEvents(block.events()) {
+			return true
+		}
+	}
+
+	if maxSnapshotAge > 0 && count > 0 {
+		snapshotTime := r.blocks[r.snapshotIndex].header().GetTimestamp().AsTime()
+		if time.Since(snapshotTime) >= maxSnapshotAge {
 			return true
 		}
 	}
@@ -564,6 +606,12 @@ func (r *streamViewImpl) ValidateNextEvent(
 	parsedEvent *ParsedEvent,
 	currentTime time.Time,
 ) error {
+	if r.IsSealed() {
+		return RiverError(Err_STREAM_SEALED, "Stream is sealed").
+			Func("ValidateNextEvent").
+			Tag("streamId", r.streamId)
+	}
+
 	// the preceding miniblock hash should reference a recent block
 	// the event should not already exist in any block after the preceding miniblock
 	// the event should not exist in the minipool
@@ -698,6 +746,18 @@ func (r *streamViewImpl) GetStats() StreamViewStats {
 	return stats
 }
 
+// sizeBytes returns the approximate memory used by the view: serialized size of its miniblocks and minipool events.
+func (r *streamViewImpl) sizeBytes() int64 {
+	var size int64
+	for _, block := range r.blocks {
+		size += block.sizeBytes()
+	}
+	for _, e := range r.minipool.events.Values {
+		size += int64(proto.Size(e.Envelope))
+	}
+	return size
+}
+
 func (r *streamViewImpl) IsMember(userAddress []byte) (bool, error) {
 	membership, err := r.GetMembership(userAddress)
 	if err != nil {
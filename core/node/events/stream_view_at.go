@@ -0,0 +1,58 @@
+package events
+
+import (
+	"context"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+// LoadStreamViewAt reconstructs the stream view as of the given miniblock, i.e. the state of the stream
+// right after miniblockNum was produced. Miniblocks are read starting from the latest snapshot at or before
+// miniblockNum up to and including miniblockNum, the minipool of the returned view is empty.
+// Snapshot derived state such as GetChannelMembers and GetPinnedMessages reflects that point in history.
+func LoadStreamViewAt(ctx context.Context, stream MiniblockStream, miniblockNum int64) (*streamViewImpl, error) {
+	if miniblockNum < 0 {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid miniblock number").
+			Func("LoadStreamViewAt").
+			Tag("miniblockNum", miniblockNum)
+	}
+
+	miniblocks, _, err := stream.GetMiniblocks(ctx, miniblockNum, miniblockNum+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(miniblocks) != 1 {
+		return nil, RiverError(Err_NOT_FOUND, "Miniblock not found").
+			Func("LoadStreamViewAt").
+			Tag("miniblockNum", miniblockNum)
+	}
+
+	target, err := NewMiniblockInfoFromProto(
+		miniblocks[0],
+		NewMiniblockInfoFromProtoOpts{ExpectedBlockNumber: miniblockNum, DontParseEvents: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotNum := target.header().PrevSnapshotMiniblockNum
+	if target.header().Snapshot != nil {
+		snapshotNum = miniblockNum
+	}
+
+	if snapshotNum < miniblockNum {
+		miniblocks, _, err = stream.GetMiniblocks(ctx, snapshotNum, miniblockNum)
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(miniblocks)) != miniblockNum-snapshotNum {
+			return nil, RiverError(Err_NOT_FOUND, "Miniblocks since snapshot not found").
+				Func("LoadStreamViewAt").
+				Tags("miniblockNum", miniblockNum, "snapshotMiniblockNum", snapshotNum, "got", len(miniblocks))
+		}
+		miniblocks = append(miniblocks, target.Proto)
+	}
+
+	return MakeRemoteStreamView(ctx, &GetStreamResponse{Stream: &StreamAndCookie{Miniblocks: miniblocks}})
+}
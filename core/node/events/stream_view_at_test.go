@@ -0,0 +1,81 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+type testMiniblockStream []*MiniblockInfo
+
+func (s testMiniblockStream) GetMiniblocks(
+	_ context.Context,
+	fromInclusive int64,
+	toExclusive int64,
+) ([]*Miniblock, bool, error) {
+	var ret []*Miniblock
+	for i := max(fromInclusive, 0); i < min(toExclusive, int64(len(s))); i++ {
+		ret = append(ret, s[i].Proto)
+	}
+	return ret, fromInclusive == 0, nil
+}
+
+func TestLoadStreamViewAt(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+	userWallet, _ := crypto.NewWallet(ctx)
+	nodeWallet, _ := crypto.NewWallet(ctx)
+	streamId := UserSettingStreamIdFromAddr(userWallet.Address)
+
+	mbs := testMiniblockStream{MakeGenesisMiniblockForUserSettingsStream(t, userWallet, nodeWallet, streamId)}
+	for range 2 {
+		mbs = append(mbs, MakeTestBlockForUserSettingsStream(t, userWallet, nodeWallet, mbs[len(mbs)-1]))
+	}
+
+	// Produce miniblock 3 with a snapshot.
+	var mbBytes [][]byte
+	for _, mb := range mbs {
+		mbBytes = append(mbBytes, toBytes(t, mb))
+	}
+	view, err := MakeStreamView(ctx, &storage.ReadStreamFromLastSnapshotResult{Miniblocks: mbBytes})
+	require.NoError(err)
+	proposal, err := view.ProposeNextMiniblock(ctx, crypto.DefaultOnChainSettings(), true)
+	require.NoError(err)
+	header, events, err := view.makeMiniblockHeader(ctx, proposal)
+	require.NoError(err)
+	require.NotNil(header.Snapshot)
+	snapshotMb, err := NewMiniblockInfoFromHeaderAndParsed(nodeWallet, header, events)
+	require.NoError(err)
+	mbs = append(mbs, snapshotMb)
+
+	viewAt, err := LoadStreamViewAt(ctx, mbs, 2)
+	require.NoError(err)
+	require.Equal(streamId, *viewAt.StreamId())
+	require.EqualValues(2, viewAt.LastBlock().Ref.Num)
+	require.Len(viewAt.MiniblocksFromLastSnapshot(), 3)
+	require.Empty(viewAt.MinipoolEnvelopes())
+	members, err := viewAt.GetChannelMembers()
+	require.NoError(err)
+	require.True((*members).Contains(userWallet.Address.Hex()))
+
+	viewAt, err = LoadStreamViewAt(ctx, mbs, 3)
+	require.NoError(err)
+	require.EqualValues(3, viewAt.LastBlock().Ref.Num)
+	require.Len(viewAt.MiniblocksFromLastSnapshot(), 1)
+	require.Equal(snapshotMb.Ref.Hash, viewAt.LastBlock().Ref.Hash)
+
+	_, err = LoadStreamViewAt(ctx, mbs, 4)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+
+	_, err = LoadStreamViewAt(ctx, mbs, -1)
+	require.Equal(Err_INVALID_ARGUMENT, AsRiverError(err).Code)
+}
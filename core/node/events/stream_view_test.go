@@ -250,6 +250,53 @@ func toBytes(t *testing.T, mb *MiniblockInfo) []byte {
 	return mbBytes
 }
 
+func TestShouldSnapshotBySizeAndAge(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+	userWallet, _ := crypto.NewWallet(ctx)
+	nodeWallet, _ := crypto.NewWallet(ctx)
+	streamId := UserSettingStreamIdFromAddr(userWallet.Address)
+
+	genMb := MakeGenesisMiniblockForUserSettingsStream(t, userWallet, nodeWallet, streamId)
+	mbBytes := [][]byte{toBytes(t, genMb)}
+
+	genesisView, err := MakeStreamView(ctx, &storage.ReadStreamFromLastSnapshotResult{Miniblocks: mbBytes})
+	require.NoError(err)
+
+	prevMb := genMb
+	eventsSize := 0
+	for range 3 {
+		mb := MakeTestBlockForUserSettingsStream(t, userWallet, nodeWallet, prevMb)
+		mbBytes = append(mbBytes, toBytes(t, mb))
+		eventsSize += proto.Size(mb.Proto.Events[0])
+		prevMb = mb
+	}
+
+	view, err := MakeStreamView(ctx, &storage.ReadStreamFromLastSnapshotResult{Miniblocks: mbBytes})
+	require.NoError(err)
+
+	// 3 events since the genesis snapshot, less than the minimum number of events.
+	cfg := crypto.DefaultOnChainSettings()
+	require.False(view.shouldSnapshot(ctx, cfg))
+
+	cfg.SnapshotTriggers.DefaultMaxBytes = uint64(eventsSize + 1)
+	require.False(view.shouldSnapshot(ctx, cfg))
+	cfg.SnapshotTriggers.DefaultMaxBytes = uint64(eventsSize)
+	require.True(view.shouldSnapshot(ctx, cfg))
+	require.EqualValues(eventsSize, cfg.SnapshotTriggers.MaxBytesForType(STREAM_USER_SETTINGS_BIN))
+	require.Zero(cfg.SnapshotTriggers.MaxBytesForType(STREAM_CHANNEL_BIN))
+
+	cfg = crypto.DefaultOnChainSettings()
+	cfg.SnapshotTriggers.DefaultMaxAge = time.Hour
+	require.False(view.shouldSnapshot(ctx, cfg))
+	cfg.SnapshotTriggers.DefaultMaxAge = time.Nanosecond
+	require.True(view.shouldSnapshot(ctx, cfg))
+
+	// Old snapshot without new events doesn't need a new snapshot.
+	require.False(genesisView.shouldSnapshot(ctx, cfg))
+}
+
 func TestMbHashConstraints(t *testing.T) {
 	ctx, cancel := test.NewTestContext()
 	defer cancel()
@@ -95,8 +95,7 @@ func makeCacheTestContext(t *testing.T, p testParams) (context.Context, *cacheTe
 		bc := btc.GetBlockchain(ctx, i)
 		bc.StartChainMonitor(ctx)
 
-		streamStore := storage.NewTestStreamStore(ctx)
-		t.Cleanup(streamStore.Close)
+		streamStore := storage.NewMemoryStreamStore()
 
 		cfg := btc.RegistryConfig()
 		registry, err := registries.NewRiverRegistryContract(
@@ -115,7 +114,7 @@ func makeCacheTestContext(t *testing.T, p testParams) (context.Context, *cacheTe
 		sr := NewStreamRegistry(bc.Wallet.Address, nr, registry, btc.OnChainConfig)
 
 		params := &StreamCacheParams{
-			Storage:                 streamStore.Storage,
+			Storage:                 streamStore,
 			Wallet:                  bc.Wallet,
 			RiverChain:              bc,
 			Registry:                registry,
@@ -309,6 +308,26 @@ func (ctc *cacheTestContext) SaveMbCandidate(
 	return stream.SaveMiniblockCandidate(ctx, mb)
 }
 
+func (ctc *cacheTestContext) GetLastMbRef(
+	ctx context.Context,
+	node common.Address,
+	streamId StreamId,
+) (*MiniblockRef, error) {
+	inst := ctc.instancesByAddr[node]
+
+	stream, err := inst.cache.getStreamImpl(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := stream.getView(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return view.LastBlock().Ref, nil
+}
+
 // GetMiniBlocksStreamed returns a range of miniblocks from the given stream.
 func (ctc *cacheTestContext) GetMbsStreamed(
 	ctx context.Context,
@@ -637,7 +637,7 @@ var file_internode_proto_rawDesc = []byte{
 	0x72, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x09, 0x6d, 0x69, 0x6e,
 	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x69,
 	0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xbb, 0x03, 0x0a, 0x0a, 0x4e, 0x6f, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x8b, 0x04, 0x0a, 0x0a, 0x4e, 0x6f, 0x64,
 	0x65, 0x54, 0x6f, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
 	0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x72, 0x69, 0x76, 0x65,
 	0x72, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
@@ -665,6 +665,11 @@ var file_internode_proto_rawDesc = []byte{
 	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x25, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69,
 	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12, 0x1a, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2d, 0x62, 0x75, 0x69, 0x6c, 0x64,
 	0x2f, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65,
@@ -700,6 +705,8 @@ var file_internode_proto_goTypes = []interface{}{
 	(*Miniblock)(nil),                      // 11: river.Miniblock
 	(*SyncCookie)(nil),                     // 12: river.SyncCookie
 	(*Envelope)(nil),                       // 13: river.Envelope
+	(*DeleteStreamRequest)(nil),            // 14: river.DeleteStreamRequest
+	(*DeleteStreamResponse)(nil),           // 15: river.DeleteStreamResponse
 }
 var file_internode_proto_depIdxs = []int32{
 	11, // 0: river.AllocateStreamRequest.miniblock:type_name -> river.Miniblock
@@ -712,13 +719,15 @@ var file_internode_proto_depIdxs = []int32{
 	5,  // 7: river.NodeToNode.NewEventInPool:input_type -> river.NewEventInPoolRequest
 	7,  // 8: river.NodeToNode.ProposeMiniblock:input_type -> river.ProposeMiniblockRequest
 	9,  // 9: river.NodeToNode.SaveMiniblockCandidate:input_type -> river.SaveMiniblockCandidateRequest
-	2,  // 10: river.NodeToNode.AllocateStream:output_type -> river.AllocateStreamResponse
-	4,  // 11: river.NodeToNode.NewEventReceived:output_type -> river.NewEventReceivedResponse
-	6,  // 12: river.NodeToNode.NewEventInPool:output_type -> river.NewEventInPoolResponse
-	8,  // 13: river.NodeToNode.ProposeMiniblock:output_type -> river.ProposeMiniblockResponse
-	10, // 14: river.NodeToNode.SaveMiniblockCandidate:output_type -> river.SaveMiniblockCandidateResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
+	14, // 10: river.NodeToNode.DeleteStreamReplica:input_type -> river.DeleteStreamRequest
+	2,  // 11: river.NodeToNode.AllocateStream:output_type -> river.AllocateStreamResponse
+	4,  // 12: river.NodeToNode.NewEventReceived:output_type -> river.NewEventReceivedResponse
+	6,  // 13: river.NodeToNode.NewEventInPool:output_type -> river.NewEventInPoolResponse
+	8,  // 14: river.NodeToNode.ProposeMiniblock:output_type -> river.ProposeMiniblockResponse
+	10, // 15: river.NodeToNode.SaveMiniblockCandidate:output_type -> river.SaveMiniblockCandidateResponse
+	15, // 16: river.NodeToNode.DeleteStreamReplica:output_type -> river.DeleteStreamResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
 	5,  // [5:5] is the sub-list for extension type_name
 	5,  // [5:5] is the sub-list for extension extendee
 	0,  // [0:5] is the sub-list for field type_name
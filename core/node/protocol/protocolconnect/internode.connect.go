@@ -48,6 +48,9 @@ const (
 	// NodeToNodeSaveMiniblockCandidateProcedure is the fully-qualified name of the NodeToNode's
 	// SaveMiniblockCandidate RPC.
 	NodeToNodeSaveMiniblockCandidateProcedure = "/river.NodeToNode/SaveMiniblockCandidate"
+	// NodeToNodeDeleteStreamReplicaProcedure is the fully-qualified name of the NodeToNode's
+	// DeleteStreamReplica RPC.
+	NodeToNodeDeleteStreamReplicaProcedure = "/river.NodeToNode/DeleteStreamReplica"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -58,6 +61,7 @@ var (
 	nodeToNodeNewEventInPoolMethodDescriptor         = nodeToNodeServiceDescriptor.Methods().ByName("NewEventInPool")
 	nodeToNodeProposeMiniblockMethodDescriptor       = nodeToNodeServiceDescriptor.Methods().ByName("ProposeMiniblock")
 	nodeToNodeSaveMiniblockCandidateMethodDescriptor = nodeToNodeServiceDescriptor.Methods().ByName("SaveMiniblockCandidate")
+	nodeToNodeDeleteStreamReplicaMethodDescriptor    = nodeToNodeServiceDescriptor.Methods().ByName("DeleteStreamReplica")
 )
 
 // NodeToNodeClient is a client for the river.NodeToNode service.
@@ -67,6 +71,9 @@ type NodeToNodeClient interface {
 	NewEventInPool(context.Context, *connect.Request[protocol.NewEventInPoolRequest]) (*connect.Response[protocol.NewEventInPoolResponse], error)
 	ProposeMiniblock(context.Context, *connect.Request[protocol.ProposeMiniblockRequest]) (*connect.Response[protocol.ProposeMiniblockResponse], error)
 	SaveMiniblockCandidate(context.Context, *connect.Request[protocol.SaveMiniblockCandidateRequest]) (*connect.Response[protocol.SaveMiniblockCandidateResponse], error)
+	// DeleteStreamReplica deletes the local replica of the stream. Request is checked by the replica
+	// the same way as by the node that received it from the client.
+	DeleteStreamReplica(context.Context, *connect.Request[protocol.DeleteStreamRequest]) (*connect.Response[protocol.DeleteStreamResponse], error)
 }
 
 // NewNodeToNodeClient constructs a client for the river.NodeToNode service. By default, it uses the
@@ -109,6 +116,12 @@ func NewNodeToNodeClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(nodeToNodeSaveMiniblockCandidateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		deleteStreamReplica: connect.NewClient[protocol.DeleteStreamRequest, protocol.DeleteStreamResponse](
+			httpClient,
+			baseURL+NodeToNodeDeleteStreamReplicaProcedure,
+			connect.WithSchema(nodeToNodeDeleteStreamReplicaMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -119,6 +132,7 @@ type nodeToNodeClient struct {
 	newEventInPool         *connect.Client[protocol.NewEventInPoolRequest, protocol.NewEventInPoolResponse]
 	proposeMiniblock       *connect.Client[protocol.ProposeMiniblockRequest, protocol.ProposeMiniblockResponse]
 	saveMiniblockCandidate *connect.Client[protocol.SaveMiniblockCandidateRequest, protocol.SaveMiniblockCandidateResponse]
+	deleteStreamReplica    *connect.Client[protocol.DeleteStreamRequest, protocol.DeleteStreamResponse]
 }
 
 // AllocateStream calls river.NodeToNode.AllocateStream.
@@ -146,6 +160,11 @@ func (c *nodeToNodeClient) SaveMiniblockCandidate(ctx context.Context, req *conn
 	return c.saveMiniblockCandidate.CallUnary(ctx, req)
 }
 
+// DeleteStreamReplica calls river.NodeToNode.DeleteStreamReplica.
+func (c *nodeToNodeClient) DeleteStreamReplica(ctx context.Context, req *connect.Request[protocol.DeleteStreamRequest]) (*connect.Response[protocol.DeleteStreamResponse], error) {
+	return c.deleteStreamReplica.CallUnary(ctx, req)
+}
+
 // NodeToNodeHandler is an implementation of the river.NodeToNode service.
 type NodeToNodeHandler interface {
 	AllocateStream(context.Context, *connect.Request[protocol.AllocateStreamRequest]) (*connect.Response[protocol.AllocateStreamResponse], error)
@@ -153,6 +172,9 @@ type NodeToNodeHandler interface {
 	NewEventInPool(context.Context, *connect.Request[protocol.NewEventInPoolRequest]) (*connect.Response[protocol.NewEventInPoolResponse], error)
 	ProposeMiniblock(context.Context, *connect.Request[protocol.ProposeMiniblockRequest]) (*connect.Response[protocol.ProposeMiniblockResponse], error)
 	SaveMiniblockCandidate(context.Context, *connect.Request[protocol.SaveMiniblockCandidateRequest]) (*connect.Response[protocol.SaveMiniblockCandidateResponse], error)
+	// DeleteStreamReplica deletes the local replica of the stream. Request is checked by the replica
+	// the same way as by the node that received it from the client.
+	DeleteStreamReplica(context.Context, *connect.Request[protocol.DeleteStreamRequest]) (*connect.Response[protocol.DeleteStreamResponse], error)
 }
 
 // NewNodeToNodeHandler builds an HTTP handler from the service implementation. It returns the path
@@ -191,6 +213,12 @@ func NewNodeToNodeHandler(svc NodeToNodeHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(nodeToNodeSaveMiniblockCandidateMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	nodeToNodeDeleteStreamReplicaHandler := connect.NewUnaryHandler(
+		NodeToNodeDeleteStreamReplicaProcedure,
+		svc.DeleteStreamReplica,
+		connect.WithSchema(nodeToNodeDeleteStreamReplicaMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/river.NodeToNode/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case NodeToNodeAllocateStreamProcedure:
@@ -203,6 +231,8 @@ func NewNodeToNodeHandler(svc NodeToNodeHandler, opts ...connect.HandlerOption)
 			nodeToNodeProposeMiniblockHandler.ServeHTTP(w, r)
 		case NodeToNodeSaveMiniblockCandidateProcedure:
 			nodeToNodeSaveMiniblockCandidateHandler.ServeHTTP(w, r)
+		case NodeToNodeDeleteStreamReplicaProcedure:
+			nodeToNodeDeleteStreamReplicaHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -231,3 +261,7 @@ func (UnimplementedNodeToNodeHandler) ProposeMiniblock(context.Context, *connect
 func (UnimplementedNodeToNodeHandler) SaveMiniblockCandidate(context.Context, *connect.Request[protocol.SaveMiniblockCandidateRequest]) (*connect.Response[protocol.SaveMiniblockCandidateResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NodeToNode.SaveMiniblockCandidate is not implemented"))
 }
+
+func (UnimplementedNodeToNodeHandler) DeleteStreamReplica(context.Context, *connect.Request[protocol.DeleteStreamRequest]) (*connect.Response[protocol.DeleteStreamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NodeToNode.DeleteStreamReplica is not implemented"))
+}
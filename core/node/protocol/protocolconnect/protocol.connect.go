@@ -47,6 +47,15 @@ const (
 	// StreamServiceGetLastMiniblockHashProcedure is the fully-qualified name of the StreamService's
 	// GetLastMiniblockHash RPC.
 	StreamServiceGetLastMiniblockHashProcedure = "/river.StreamService/GetLastMiniblockHash"
+	// StreamServiceGetStreamAtProcedure is the fully-qualified name of the StreamService's GetStreamAt
+	// RPC.
+	StreamServiceGetStreamAtProcedure = "/river.StreamService/GetStreamAt"
+	// StreamServiceGetEventProofProcedure is the fully-qualified name of the StreamService's
+	// GetEventProof RPC.
+	StreamServiceGetEventProofProcedure = "/river.StreamService/GetEventProof"
+	// StreamServiceDeleteStreamProcedure is the fully-qualified name of the StreamService's
+	// DeleteStream RPC.
+	StreamServiceDeleteStreamProcedure = "/river.StreamService/DeleteStream"
 	// StreamServiceAddEventProcedure is the fully-qualified name of the StreamService's AddEvent RPC.
 	StreamServiceAddEventProcedure = "/river.StreamService/AddEvent"
 	// StreamServiceSyncStreamsProcedure is the fully-qualified name of the StreamService's SyncStreams
@@ -78,6 +87,9 @@ var (
 	streamServiceGetStreamExMethodDescriptor          = streamServiceServiceDescriptor.Methods().ByName("GetStreamEx")
 	streamServiceGetMiniblocksMethodDescriptor        = streamServiceServiceDescriptor.Methods().ByName("GetMiniblocks")
 	streamServiceGetLastMiniblockHashMethodDescriptor = streamServiceServiceDescriptor.Methods().ByName("GetLastMiniblockHash")
+	streamServiceGetStreamAtMethodDescriptor          = streamServiceServiceDescriptor.Methods().ByName("GetStreamAt")
+	streamServiceGetEventProofMethodDescriptor        = streamServiceServiceDescriptor.Methods().ByName("GetEventProof")
+	streamServiceDeleteStreamMethodDescriptor         = streamServiceServiceDescriptor.Methods().ByName("DeleteStream")
 	streamServiceAddEventMethodDescriptor             = streamServiceServiceDescriptor.Methods().ByName("AddEvent")
 	streamServiceSyncStreamsMethodDescriptor          = streamServiceServiceDescriptor.Methods().ByName("SyncStreams")
 	streamServiceAddStreamToSyncMethodDescriptor      = streamServiceServiceDescriptor.Methods().ByName("AddStreamToSync")
@@ -95,6 +107,9 @@ type StreamServiceClient interface {
 	GetStreamEx(context.Context, *connect.Request[protocol.GetStreamExRequest]) (*connect.ServerStreamForClient[protocol.GetStreamExResponse], error)
 	GetMiniblocks(context.Context, *connect.Request[protocol.GetMiniblocksRequest]) (*connect.Response[protocol.GetMiniblocksResponse], error)
 	GetLastMiniblockHash(context.Context, *connect.Request[protocol.GetLastMiniblockHashRequest]) (*connect.Response[protocol.GetLastMiniblockHashResponse], error)
+	GetStreamAt(context.Context, *connect.Request[protocol.GetStreamAtRequest]) (*connect.Response[protocol.GetStreamAtResponse], error)
+	GetEventProof(context.Context, *connect.Request[protocol.GetEventProofRequest]) (*connect.Response[protocol.GetEventProofResponse], error)
+	DeleteStream(context.Context, *connect.Request[protocol.DeleteStreamRequest]) (*connect.Response[protocol.DeleteStreamResponse], error)
 	AddEvent(context.Context, *connect.Request[protocol.AddEventRequest]) (*connect.Response[protocol.AddEventResponse], error)
 	SyncStreams(context.Context, *connect.Request[protocol.SyncStreamsRequest]) (*connect.ServerStreamForClient[protocol.SyncStreamsResponse], error)
 	AddStreamToSync(context.Context, *connect.Request[protocol.AddStreamToSyncRequest]) (*connect.Response[protocol.AddStreamToSyncResponse], error)
@@ -150,6 +165,24 @@ func NewStreamServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(streamServiceGetLastMiniblockHashMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getStreamAt: connect.NewClient[protocol.GetStreamAtRequest, protocol.GetStreamAtResponse](
+			httpClient,
+			baseURL+StreamServiceGetStreamAtProcedure,
+			connect.WithSchema(streamServiceGetStreamAtMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getEventProof: connect.NewClient[protocol.GetEventProofRequest, protocol.GetEventProofResponse](
+			httpClient,
+			baseURL+StreamServiceGetEventProofProcedure,
+			connect.WithSchema(streamServiceGetEventProofMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteStream: connect.NewClient[protocol.DeleteStreamRequest, protocol.DeleteStreamResponse](
+			httpClient,
+			baseURL+StreamServiceDeleteStreamProcedure,
+			connect.WithSchema(streamServiceDeleteStreamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		addEvent: connect.NewClient[protocol.AddEventRequest, protocol.AddEventResponse](
 			httpClient,
 			baseURL+StreamServiceAddEventProcedure,
@@ -208,6 +241,9 @@ type streamServiceClient struct {
 	getStreamEx          *connect.Client[protocol.GetStreamExRequest, protocol.GetStreamExResponse]
 	getMiniblocks        *connect.Client[protocol.GetMiniblocksRequest, protocol.GetMiniblocksResponse]
 	getLastMiniblockHash *connect.Client[protocol.GetLastMiniblockHashRequest, protocol.GetLastMiniblockHashResponse]
+	getStreamAt          *connect.Client[protocol.GetStreamAtRequest, protocol.GetStreamAtResponse]
+	getEventProof        *connect.Client[protocol.GetEventProofRequest, protocol.GetEventProofResponse]
+	deleteStream         *connect.Client[protocol.DeleteStreamRequest, protocol.DeleteStreamResponse]
 	addEvent             *connect.Client[protocol.AddEventRequest, protocol.AddEventResponse]
 	syncStreams          *connect.Client[protocol.SyncStreamsRequest, protocol.SyncStreamsResponse]
 	addStreamToSync      *connect.Client[protocol.AddStreamToSyncRequest, protocol.AddStreamToSyncResponse]
@@ -243,6 +279,21 @@ func (c *streamServiceClient) GetLastMiniblockHash(ctx context.Context, req *con
 	return c.getLastMiniblockHash.CallUnary(ctx, req)
 }
 
+// GetStreamAt calls river.StreamService.GetStreamAt.
+func (c *streamServiceClient) GetStreamAt(ctx context.Context, req *connect.Request[protocol.GetStreamAtRequest]) (*connect.Response[protocol.GetStreamAtResponse], error) {
+	return c.getStreamAt.CallUnary(ctx, req)
+}
+
+// GetEventProof calls river.StreamService.GetEventProof.
+func (c *streamServiceClient) GetEventProof(ctx context.Context, req *connect.Request[protocol.GetEventProofRequest]) (*connect.Response[protocol.GetEventProofResponse], error) {
+	return c.getEventProof.CallUnary(ctx, req)
+}
+
+// DeleteStream calls river.StreamService.DeleteStream.
+func (c *streamServiceClient) DeleteStream(ctx context.Context, req *connect.Request[protocol.DeleteStreamRequest]) (*connect.Response[protocol.DeleteStreamResponse], error) {
+	return c.deleteStream.CallUnary(ctx, req)
+}
+
 // AddEvent calls river.StreamService.AddEvent.
 func (c *streamServiceClient) AddEvent(ctx context.Context, req *connect.Request[protocol.AddEventRequest]) (*connect.Response[protocol.AddEventResponse], error) {
 	return c.addEvent.CallUnary(ctx, req)
@@ -290,6 +341,9 @@ type StreamServiceHandler interface {
 	GetStreamEx(context.Context, *connect.Request[protocol.GetStreamExRequest], *connect.ServerStream[protocol.GetStreamExResponse]) error
 	GetMiniblocks(context.Context, *connect.Request[protocol.GetMiniblocksRequest]) (*connect.Response[protocol.GetMiniblocksResponse], error)
 	GetLastMiniblockHash(context.Context, *connect.Request[protocol.GetLastMiniblockHashRequest]) (*connect.Response[protocol.GetLastMiniblockHashResponse], error)
+	GetStreamAt(context.Context, *connect.Request[protocol.GetStreamAtRequest]) (*connect.Response[protocol.GetStreamAtResponse], error)
+	GetEventProof(context.Context, *connect.Request[protocol.GetEventProofRequest]) (*connect.Response[protocol.GetEventProofResponse], error)
+	DeleteStream(context.Context, *connect.Request[protocol.DeleteStreamRequest]) (*connect.Response[protocol.DeleteStreamResponse], error)
 	AddEvent(context.Context, *connect.Request[protocol.AddEventRequest]) (*connect.Response[protocol.AddEventResponse], error)
 	SyncStreams(context.Context, *connect.Request[protocol.SyncStreamsRequest], *connect.ServerStream[protocol.SyncStreamsResponse]) error
 	AddStreamToSync(context.Context, *connect.Request[protocol.AddStreamToSyncRequest]) (*connect.Response[protocol.AddStreamToSyncResponse], error)
@@ -341,6 +395,24 @@ func NewStreamServiceHandler(svc StreamServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(streamServiceGetLastMiniblockHashMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	streamServiceGetStreamAtHandler := connect.NewUnaryHandler(
+		StreamServiceGetStreamAtProcedure,
+		svc.GetStreamAt,
+		connect.WithSchema(streamServiceGetStreamAtMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	streamServiceGetEventProofHandler := connect.NewUnaryHandler(
+		StreamServiceGetEventProofProcedure,
+		svc.GetEventProof,
+		connect.WithSchema(streamServiceGetEventProofMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	streamServiceDeleteStreamHandler := connect.NewUnaryHandler(
+		StreamServiceDeleteStreamProcedure,
+		svc.DeleteStream,
+		connect.WithSchema(streamServiceDeleteStreamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	streamServiceAddEventHandler := connect.NewUnaryHandler(
 		StreamServiceAddEventProcedure,
 		svc.AddEvent,
@@ -401,6 +473,12 @@ func NewStreamServiceHandler(svc StreamServiceHandler, opts ...connect.HandlerOp
 			streamServiceGetMiniblocksHandler.ServeHTTP(w, r)
 		case StreamServiceGetLastMiniblockHashProcedure:
 			streamServiceGetLastMiniblockHashHandler.ServeHTTP(w, r)
+		case StreamServiceGetStreamAtProcedure:
+			streamServiceGetStreamAtHandler.ServeHTTP(w, r)
+		case StreamServiceGetEventProofProcedure:
+			streamServiceGetEventProofHandler.ServeHTTP(w, r)
+		case StreamServiceDeleteStreamProcedure:
+			streamServiceDeleteStreamHandler.ServeHTTP(w, r)
 		case StreamServiceAddEventProcedure:
 			streamServiceAddEventHandler.ServeHTTP(w, r)
 		case StreamServiceSyncStreamsProcedure:
@@ -446,6 +524,18 @@ func (UnimplementedStreamServiceHandler) GetLastMiniblockHash(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetLastMiniblockHash is not implemented"))
 }
 
+func (UnimplementedStreamServiceHandler) GetStreamAt(context.Context, *connect.Request[protocol.GetStreamAtRequest]) (*connect.Response[protocol.GetStreamAtResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetStreamAt is not implemented"))
+}
+
+func (UnimplementedStreamServiceHandler) GetEventProof(context.Context, *connect.Request[protocol.GetEventProofRequest]) (*connect.Response[protocol.GetEventProofResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetEventProof is not implemented"))
+}
+
+func (UnimplementedStreamServiceHandler) DeleteStream(context.Context, *connect.Request[protocol.DeleteStreamRequest]) (*connect.Response[protocol.DeleteStreamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.DeleteStream is not implemented"))
+}
+
 func (UnimplementedStreamServiceHandler) AddEvent(context.Context, *connect.Request[protocol.AddEventRequest]) (*connect.Response[protocol.AddEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.AddEvent is not implemented"))
 }
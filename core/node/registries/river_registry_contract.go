@@ -232,6 +232,9 @@ func (c *RiverRegistryContract) AllocateStream(
 	return RiverError(Err_ERR_UNSPECIFIED, "AllocateStream transaction result unknown")
 }
 
+// StreamFlagSealed is set in the stream record flags when the stream is sealed.
+const StreamFlagSealed uint64 = 1
+
 type GetStreamResult struct {
 	StreamId          StreamId
 	Nodes             []common.Address
@@ -246,7 +249,7 @@ func makeGetStreamResult(streamId StreamId, stream *river.Stream) *GetStreamResu
 		Nodes:             stream.Nodes,
 		LastMiniblockHash: stream.LastMiniblockHash,
 		LastMiniblockNum:  stream.LastMiniblockNum,
-		IsSealed:          stream.Flags&1 != 0, // TODO: constants for flags
+		IsSealed:          stream.Flags&StreamFlagSealed != 0,
 	}
 }
 
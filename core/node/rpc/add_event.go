@@ -36,7 +36,7 @@ func (s *Service) localAddEvent(
 
 	log.Debug("localAddEvent", "parsedEvent", parsedEvent)
 
-	err = s.addParsedEvent(ctx, streamId, parsedEvent, nodes)
+	acknowledgedBy, err := s.addParsedEvent(ctx, streamId, parsedEvent, nodes)
 	if err != nil && req.Msg.Optional {
 		// aellis 5/2024 - we only want to wrap errors from canAddEvent,
 		// currently this is catching all errors, which is not ideal
@@ -51,7 +51,11 @@ func (s *Service) localAddEvent(
 	} else if err != nil {
 		return nil, AsRiverError(err).Func("localAddEvent")
 	} else {
-		return connect.NewResponse(&AddEventResponse{}), nil
+		resp := &AddEventResponse{}
+		for _, node := range acknowledgedBy {
+			resp.AcknowledgedBy = append(resp.AcknowledgedBy, node.Bytes())
+		}
+		return connect.NewResponse(resp), nil
 	}
 }
 
@@ -60,15 +64,15 @@ func (s *Service) addParsedEvent(
 	streamId StreamId,
 	parsedEvent *ParsedEvent,
 	nodes StreamNodes,
-) error {
+) ([]common.Address, error) {
 	localStream, err := s.cache.GetStream(ctx, streamId)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	streamView, err := localStream.GetView(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	_, _ = s.scrubTaskProcessor.TryScheduleScrub(ctx, localStream, false)
@@ -83,7 +87,7 @@ func (s *Service) addParsedEvent(
 	)
 
 	if !canAddEvent || err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(chainAuthArgsList) > 0 {
@@ -93,7 +97,7 @@ func (s *Service) addParsedEvent(
 		for _, chainAuthArgs := range chainAuthArgsList {
 			isEntitled, err = s.chainAuth.IsEntitled(ctx, s.config, chainAuthArgs)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			if isEntitled {
 				break
@@ -108,10 +112,10 @@ func (s *Service) addParsedEvent(
 					sideEffects.OnChainAuthFailure.Payload,
 				)
 				if err != nil {
-					return err
+					return nil, err
 				}
 			}
-			return RiverError(
+			return nil, RiverError(
 				Err_PERMISSION_DENIED,
 				"IsEntitled failed",
 				"chainAuthArgsList",
@@ -120,10 +124,18 @@ func (s *Service) addParsedEvent(
 		}
 	}
 
+	// Only events that are allowed to be added count against the rate limit.
+	if parsedEvent.Event.Ephemeral {
+		err := s.ephemeralLimiter.allow(time.Now(), streamId, common.BytesToAddress(parsedEvent.Event.CreatorAddress))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if sideEffects.RequiredParentEvent != nil {
 		err := s.AddEventPayload(ctx, sideEffects.RequiredParentEvent.StreamId, sideEffects.RequiredParentEvent.Payload)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -132,14 +144,10 @@ func (s *Service) addParsedEvent(
 		localStream: localStream,
 		nodes:       nodes,
 		service:     s,
+		consistency: s.chainConfig.Get().WriteConsistency.ForType(streamId.Type()),
 	}
 
-	err = stream.AddEvent(ctx, parsedEvent)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return stream.addEvent(ctx, parsedEvent)
 }
 
 func (s *Service) AddEventPayload(ctx context.Context, streamId StreamId, payload IsStreamEvent_Payload) error {
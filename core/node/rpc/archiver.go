@@ -28,6 +28,8 @@ type ArchiveStream struct {
 	nodes               nodes.StreamNodes
 	numBlocksInContract atomic.Int64
 	numBlocksInDb       atomic.Int64 // -1 means not loaded
+	// sealed streams don't get new miniblocks, they are skipped once fully archived.
+	sealed atomic.Bool
 
 	// Mutex is used so only one archive operation is performed at a time.
 	mu sync.Mutex
@@ -44,6 +46,12 @@ func NewArchiveStream(streamId StreamId, nn *[]common.Address, lastKnownMinibloc
 	return stream
 }
 
+// archived returns true if the stream is sealed and all its miniblocks are archived.
+func (s *ArchiveStream) archived() bool {
+	mbsInDb := s.numBlocksInDb.Load()
+	return s.sealed.Load() && mbsInDb > -1 && mbsInDb >= s.numBlocksInContract.Load()
+}
+
 type Archiver struct {
 	config       *config.ArchiveConfig
 	contract     *registries.RiverRegistryContract
@@ -64,6 +72,7 @@ type Archiver struct {
 	streamsExamined            atomic.Uint64
 	streamsCreated             atomic.Uint64
 	streamsUpToDate            atomic.Uint64
+	streamsSealed              atomic.Uint64
 	successOpsCount            atomic.Uint64
 	failedOpsCount             atomic.Uint64
 	miniblocksProcessed        atomic.Uint64
@@ -76,6 +85,7 @@ type ArchiverStats struct {
 	StreamsExamined            uint64
 	StreamsCreated             uint64
 	StreamsUpToDate            uint64
+	StreamsSealed              uint64
 	SuccessOpsCount            uint64
 	FailedOpsCount             uint64
 	MiniblocksProcessed        uint64
@@ -106,8 +116,11 @@ func (a *Archiver) addNewStream(
 	streamId StreamId,
 	nn *[]common.Address,
 	lastKnownMiniblock uint64,
+	isSealed bool,
 ) {
-	_, loaded := a.streams.LoadOrStore(streamId, NewArchiveStream(streamId, nn, lastKnownMiniblock))
+	stream := NewArchiveStream(streamId, nn, lastKnownMiniblock)
+	stream.sealed.Store(isSealed)
+	_, loaded := a.streams.LoadOrStore(streamId, stream)
 	if loaded {
 		// TODO: Double notification, shouldn't happen.
 		dlog.FromCtx(ctx).
@@ -133,6 +146,10 @@ func (a *Archiver) ArchiveStream(ctx context.Context, stream *ArchiveStream) err
 	}
 	defer stream.mu.Unlock()
 
+	if stream.archived() {
+		return nil
+	}
+
 	mbsInDb := stream.numBlocksInDb.Load()
 
 	// Check if stream info was loaded from db.
@@ -158,6 +175,9 @@ func (a *Archiver) ArchiveStream(ctx context.Context, stream *ArchiveStream) err
 	mbsInContract := stream.numBlocksInContract.Load()
 	if mbsInDb >= mbsInContract {
 		a.streamsUpToDate.Add(1)
+		if stream.sealed.Load() {
+			a.streamsSealed.Add(1)
+		}
 		return nil
 	}
 
@@ -254,6 +274,9 @@ func (a *Archiver) ArchiveStream(ctx context.Context, stream *ArchiveStream) err
 
 		a.miniblocksProcessed.Add(uint64(len(serialized)))
 	}
+	if stream.sealed.Load() {
+		a.streamsSealed.Add(1)
+	}
 	return nil
 }
 
@@ -310,7 +333,13 @@ func (a *Archiver) startImpl(ctx context.Context, once bool) error {
 			}
 			log := dlog.FromCtx(ctx)
 			log.Debug("Adding stream via detecting presence in stream registry", "streamId", stream.Id)
-			a.addNewStream(ctx, stream.Id, &stream.Stream.Nodes, stream.Stream.LastMiniblockNum)
+			a.addNewStream(
+				ctx,
+				stream.Id,
+				&stream.Stream.Nodes,
+				stream.Stream.LastMiniblockNum,
+				stream.Stream.Flags&registries.StreamFlagSealed != 0,
+			)
 		}
 	}
 
@@ -335,7 +364,7 @@ func (a *Archiver) startImpl(ctx context.Context, once bool) error {
 func (a *Archiver) onStreamAllocated(ctx context.Context, event *river.StreamRegistryV1StreamAllocated) {
 	a.newStreamAllocated.Add(1)
 	id := StreamId(event.StreamId)
-	a.addNewStream(ctx, id, &event.Nodes, 0)
+	a.addNewStream(ctx, id, &event.Nodes, 0, false)
 	a.tasks <- id
 }
 
@@ -368,7 +397,13 @@ func (a *Archiver) onStreamLastMiniblockUpdated(
 		return
 	}
 	stream := record.(*ArchiveStream)
+	if stream.archived() {
+		return
+	}
 	stream.numBlocksInContract.Store(int64(event.LastMiniblockNum + 1))
+	if event.IsSealed {
+		stream.sealed.Store(true)
+	}
 	a.tasks <- id
 }
 
@@ -390,6 +425,7 @@ func (a *Archiver) GetStats() *ArchiverStats {
 		StreamsExamined:            a.streamsExamined.Load(),
 		StreamsCreated:             a.streamsCreated.Load(),
 		StreamsUpToDate:            a.streamsUpToDate.Load(),
+		StreamsSealed:              a.streamsSealed.Load(),
 		SuccessOpsCount:            a.successOpsCount.Load(),
 		FailedOpsCount:             a.failedOpsCount.Load(),
 		MiniblocksProcessed:        a.miniblocksProcessed.Load(),
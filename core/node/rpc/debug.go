@@ -10,7 +10,9 @@ import (
 	"runtime"
 	runtimePProf "runtime/pprof"
 	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/river-build/river/core/config"
@@ -18,6 +20,7 @@ import (
 	"github.com/river-build/river/core/node/dlog"
 	. "github.com/river-build/river/core/node/events"
 	"github.com/river-build/river/core/node/rpc/render"
+	"github.com/river-build/river/core/node/storage"
 )
 
 type debugHandler struct {
@@ -67,10 +70,18 @@ func (s *Service) registerDebugHandlers(enableDebugEndpoints bool, cfg config.De
 	handler.HandleFunc(mux, "/debug/multi/json", s.handleDebugMultiJson)
 	handler.Handle(mux, "/debug/config", &onChainConfigHandler{onChainConfig: s.chainConfig})
 
-	if enableDebugEndpoints && cfg.EnableStorageEndpoint {
+	if enableDebugEndpoints && cfg.EnableStorageEndpoint && s.storagePoolInfo != nil {
 		handler.HandleFunc(mux, "/debug/storage", s.handleDebugStorage)
 	}
 
+	if enableDebugEndpoints && cfg.EnableStorageEndpoint {
+		handler.Handle(mux, "/debug/storage/usage", &storageUsageHandler{storage: s.storage})
+	}
+
+	if enableDebugEndpoints && cfg.EnableStorageEndpoint && s.pgStorage != nil {
+		handler.HandleFunc(mux, "/debug/storage/candidates", s.handleDebugMiniblockCandidates)
+	}
+
 	if cfg.Cache || enableDebugEndpoints {
 		handler.Handle(mux, "/debug/cache", &cacheHandler{cache: s.cache})
 	}
@@ -214,6 +225,48 @@ func (h *txpoolHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write(output.Bytes())
 }
 
+// storageUsageHandler lists the largest streams of each type. Growth of streams is reported
+// since the previous request to the handler.
+type storageUsageHandler struct {
+	storage storage.StreamStorage
+
+	mu       sync.Mutex
+	previous *storage.StorageUsageSample
+}
+
+func (h *storageUsageHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	top := 0
+	if v := r.URL.Query().Get("top"); v != "" {
+		var err error
+		top, err = strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "Bad top: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	streams, err := h.storage.GetStreamsStorageUsage(ctx)
+	if err != nil {
+		dlog.FromCtx(ctx).Error("unable to read storage usage", "err", err)
+		http.Error(w, "Internal Server Error: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sample := &storage.StorageUsageSample{Time: time.Now(), Streams: streams}
+	report := storage.NewStorageUsageReport(sample, h.previous, top)
+	h.previous = sample
+
+	err = render.ExecuteAndWrite(&render.StorageUsageData{Report: report}, w)
+	if err != nil {
+		dlog.FromCtx(ctx).Error("unable to render storage usage", "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
 type cacheHandler struct {
 	cache StreamCache
 }
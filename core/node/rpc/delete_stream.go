@@ -0,0 +1,127 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/dlog"
+	"github.com/river-build/river/core/node/nodes"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+// checkDeleteStreamRequest checks that the request is recent and is signed either by the user that owns the stream
+// or by an address allowed to delete streams. Returns the tombstone to record for the stream.
+func checkDeleteStreamRequest(
+	cfg config.StreamDeletionConfig,
+	req *DeleteStreamRequest,
+	now time.Time,
+) (*storage.StreamTombstone, error) {
+	streamId, err := StreamIdFromBytes(req.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.SignerAddress) != common.AddressLength {
+		return nil, RiverError(Err_BAD_ADDRESS, "Bad signer address").
+			Func("checkDeleteStreamRequest").
+			Tag("signerAddress", req.SignerAddress)
+	}
+	signer := common.BytesToAddress(req.SignerAddress)
+
+	requestedAt := time.UnixMilli(req.RequestedAtMs)
+	if maxAge := cfg.GetMaxRequestAge(); now.Sub(requestedAt) > maxAge || requestedAt.Sub(now) > maxAge {
+		return nil, RiverError(Err_PERMISSION_DENIED, "Delete stream request is expired").
+			Func("checkDeleteStreamRequest").
+			Tags("streamId", streamId, "requestedAt", requestedAt)
+	}
+
+	err = crypto.CheckDeleteStreamSig(req.SignerAddress, req.StreamId, req.Reason, req.RequestedAtMs, req.Signature)
+	if err != nil {
+		return nil, AsRiverError(err).Func("checkDeleteStreamRequest")
+	}
+
+	owner, err := GetUserAddressFromStreamId(streamId)
+	if (err != nil || owner != signer) && !cfg.IsAllowedAddress(signer) {
+		return nil, RiverError(Err_PERMISSION_DENIED, "Signer is not allowed to delete the stream").
+			Func("checkDeleteStreamRequest").
+			Tags("streamId", streamId, "signer", signer)
+	}
+
+	return &storage.StreamTombstone{
+		StreamId:  streamId,
+		DeletedAt: now,
+		Requester: signer,
+		Reason:    req.Reason,
+	}, nil
+}
+
+// localDeleteStream deletes the stream on this node and on all other nodes of the stream.
+// If some of the nodes fail, UNAVAILABLE is returned and the request can be retried,
+// nodes that already deleted the stream accept the request again.
+func (s *Service) localDeleteStream(
+	ctx context.Context,
+	req *connect.Request[DeleteStreamRequest],
+	streamNodes nodes.StreamNodes,
+) (*connect.Response[DeleteStreamResponse], error) {
+	tombstone, err := checkDeleteStreamRequest(s.config.StreamDeletion, req.Msg, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	remotes := streamNodes.GetRemotes()
+	remoteErrs := make([]error, len(remotes))
+	var wg sync.WaitGroup
+	for i, node := range remotes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			remoteErrs[i] = s.deleteStreamReplica(ctx, node, req.Msg)
+		}()
+	}
+	err = s.cache.DeleteStream(ctx, tombstone)
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &DeleteStreamResponse{DeletedOn: [][]byte{s.wallet.Address.Bytes()}}
+	var failed []common.Address
+	for i, node := range remotes {
+		if remoteErrs[i] != nil {
+			dlog.FromCtx(ctx).Warn(
+				"localDeleteStream: Failed to delete stream replica",
+				"streamId", tombstone.StreamId,
+				"node", node,
+				"err", remoteErrs[i],
+			)
+			failed = append(failed, node)
+		} else {
+			resp.DeletedOn = append(resp.DeletedOn, node.Bytes())
+		}
+	}
+	if len(failed) > 0 {
+		return nil, RiverError(Err_UNAVAILABLE, "Stream is not deleted on some nodes, request should be retried").
+			Func("localDeleteStream").
+			Tags("streamId", tombstone.StreamId, "failedNodes", failed)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) deleteStreamReplica(ctx context.Context, node common.Address, req *DeleteStreamRequest) error {
+	stub, err := s.nodeRegistry.GetNodeToNodeClientForAddress(node)
+	if err != nil {
+		return err
+	}
+	_, err = stub.DeleteStreamReplica(ctx, connect.NewRequest(req))
+	return err
+}
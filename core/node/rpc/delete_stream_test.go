@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/stretchr/testify/require"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func makeDeleteStreamRequest(
+	t *testing.T,
+	wallet *crypto.Wallet,
+	streamId StreamId,
+	requestedAt time.Time,
+) *DeleteStreamRequest {
+	req := &DeleteStreamRequest{
+		StreamId:      streamId[:],
+		Reason:        "test",
+		RequestedAtMs: requestedAt.UnixMilli(),
+		SignerAddress: wallet.Address.Bytes(),
+	}
+	sig, err := wallet.SignHash(
+		accounts.TextHash(crypto.RiverDeleteStreamHashSrc(req.StreamId, req.Reason, req.RequestedAtMs)),
+	)
+	require.NoError(t, err)
+	req.Signature = sig
+	return req
+}
+
+func TestCheckDeleteStreamRequest(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+
+	owner, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	admin, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	other, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+
+	cfg := config.StreamDeletionConfig{AllowedAddresses: admin.Address.Hex()}
+	userStream := UserSettingStreamIdFromAddr(owner.Address)
+	channelStream := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	now := time.Now()
+
+	// Owner can delete own user stream.
+	tombstone, err := checkDeleteStreamRequest(cfg, makeDeleteStreamRequest(t, owner, userStream, now), now)
+	require.NoError(err)
+	require.Equal(userStream, tombstone.StreamId)
+	require.Equal(owner.Address, tombstone.Requester)
+	require.Equal("test", tombstone.Reason)
+
+	// Admin can delete any stream.
+	_, err = checkDeleteStreamRequest(cfg, makeDeleteStreamRequest(t, admin, userStream, now), now)
+	require.NoError(err)
+	_, err = checkDeleteStreamRequest(cfg, makeDeleteStreamRequest(t, admin, channelStream, now), now)
+	require.NoError(err)
+
+	// Others can't.
+	_, err = checkDeleteStreamRequest(cfg, makeDeleteStreamRequest(t, other, userStream, now), now)
+	require.Equal(Err_PERMISSION_DENIED, AsRiverError(err).Code)
+	_, err = checkDeleteStreamRequest(cfg, makeDeleteStreamRequest(t, owner, channelStream, now), now)
+	require.Equal(Err_PERMISSION_DENIED, AsRiverError(err).Code)
+
+	// Expired request is rejected.
+	old := now.Add(-2 * cfg.GetMaxRequestAge())
+	_, err = checkDeleteStreamRequest(cfg, makeDeleteStreamRequest(t, owner, userStream, old), now)
+	require.Equal(Err_PERMISSION_DENIED, AsRiverError(err).Code)
+
+	// Signature of the other wallet is rejected.
+	req := makeDeleteStreamRequest(t, other, userStream, now)
+	req.SignerAddress = owner.Address.Bytes()
+	_, err = checkDeleteStreamRequest(cfg, req, now)
+	require.Error(err)
+}
@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+type ephemeralLimiterKey struct {
+	streamId StreamId
+	creator  common.Address
+}
+
+// ephemeralEventLimiter counts ephemeral events per user per stream and per stream
+// in one second windows. Counters are reset when the window changes.
+type ephemeralEventLimiter struct {
+	cfg config.EphemeralEventsConfig
+
+	mu      sync.Mutex
+	window  int64
+	users   map[ephemeralLimiterKey]int
+	streams map[StreamId]int
+}
+
+func newEphemeralEventLimiter(cfg config.EphemeralEventsConfig) *ephemeralEventLimiter {
+	return &ephemeralEventLimiter{
+		cfg:     cfg,
+		users:   make(map[ephemeralLimiterKey]int),
+		streams: make(map[StreamId]int),
+	}
+}
+
+// allow records the event and returns RESOURCE_EXHAUSTED error if the user or the stream is over its limit.
+func (l *ephemeralEventLimiter) allow(now time.Time, streamId StreamId, creator common.Address) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if window := now.Unix(); window != l.window {
+		l.window = window
+		clear(l.users)
+		clear(l.streams)
+	}
+
+	key := ephemeralLimiterKey{streamId: streamId, creator: creator}
+	if l.users[key] >= l.cfg.GetMaxPerUserPerSecond() {
+		return RiverError(Err_RESOURCE_EXHAUSTED, "Too many ephemeral events from user").
+			Func("ephemeralEventLimiter.allow").
+			Tags("streamId", streamId, "creator", creator)
+	}
+	if l.streams[streamId] >= l.cfg.GetMaxPerStreamPerSecond() {
+		return RiverError(Err_RESOURCE_EXHAUSTED, "Too many ephemeral events in stream").
+			Func("ephemeralEventLimiter.allow").
+			Tag("streamId", streamId)
+	}
+	l.users[key]++
+	l.streams[streamId]++
+	return nil
+}
@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestEphemeralEventLimiter(t *testing.T) {
+	require := require.New(t)
+
+	limiter := newEphemeralEventLimiter(config.EphemeralEventsConfig{MaxPerUserPerSecond: 2, MaxPerStreamPerSecond: 3})
+	stream1 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	stream2 := testutils.FakeStreamId(STREAM_DM_CHANNEL_BIN)
+	user1 := common.HexToAddress("0x1")
+	user2 := common.HexToAddress("0x2")
+	now := time.Unix(1000, 0)
+
+	require.NoError(limiter.allow(now, stream1, user1))
+	require.NoError(limiter.allow(now, stream1, user1))
+	err := limiter.allow(now, stream1, user1)
+	require.Equal(Err_RESOURCE_EXHAUSTED, AsRiverError(err).Code)
+
+	// Limits are per stream.
+	require.NoError(limiter.allow(now, stream2, user1))
+
+	// Stream limit applies to all users.
+	require.NoError(limiter.allow(now, stream1, user2))
+	err = limiter.allow(now, stream1, user2)
+	require.Equal(Err_RESOURCE_EXHAUSTED, AsRiverError(err).Code)
+
+	// Counters are reset in the next window.
+	now = now.Add(time.Second)
+	require.NoError(limiter.allow(now, stream1, user1))
+	require.NoError(limiter.allow(now, stream1, user2))
+}
@@ -420,6 +420,159 @@ func (s *Service) getLastMiniblockHashImpl(
 	)
 }
 
+func (s *Service) GetStreamAt(
+	ctx context.Context,
+	req *connect.Request[GetStreamAtRequest],
+) (*connect.Response[GetStreamAtResponse], error) {
+	ctx, log := utils.CtxAndLogForRequest(ctx, req)
+	log.Debug("GetStreamAt ENTER", "req", req.Msg)
+	r, e := s.getStreamAtImpl(ctx, req)
+	if e != nil {
+		return nil, s.asAnnotatedRiverError(e).
+			Func("GetStreamAt").
+			Tags("req.Msg.StreamId", req.Msg.StreamId, "req.Msg.MiniblockNum", req.Msg.MiniblockNum).
+			LogWarn(log).
+			AsConnectError()
+	}
+	log.Debug("GetStreamAt LEAVE", "response", r.Msg)
+	return r, nil
+}
+
+func (s *Service) getStreamAtImpl(
+	ctx context.Context,
+	req *connect.Request[GetStreamAtRequest],
+) (*connect.Response[GetStreamAtResponse], error) {
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := s.streamRegistry.GetStreamInfo(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if nodes.IsLocal() {
+		return s.localGetStreamAt(ctx, req)
+	}
+
+	return peerNodeRequestWithRetries(
+		ctx,
+		nodes,
+		s,
+		func(ctx context.Context, stub StreamServiceClient) (*connect.Response[GetStreamAtResponse], error) {
+			ret, err := stub.GetStreamAt(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(ret.Msg), nil
+		},
+		-1,
+	)
+}
+
+func (s *Service) GetEventProof(
+	ctx context.Context,
+	req *connect.Request[GetEventProofRequest],
+) (*connect.Response[GetEventProofResponse], error) {
+	ctx, log := utils.CtxAndLogForRequest(ctx, req)
+	log.Debug("GetEventProof ENTER", "req", req.Msg)
+	r, e := s.getEventProofImpl(ctx, req)
+	if e != nil {
+		return nil, s.asAnnotatedRiverError(e).
+			Func("GetEventProof").
+			Tags("req.Msg.StreamId", req.Msg.StreamId, "req.Msg.EventHash", req.Msg.EventHash).
+			LogWarn(log).
+			AsConnectError()
+	}
+	log.Debug("GetEventProof LEAVE", "response", r.Msg)
+	return r, nil
+}
+
+func (s *Service) getEventProofImpl(
+	ctx context.Context,
+	req *connect.Request[GetEventProofRequest],
+) (*connect.Response[GetEventProofResponse], error) {
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := s.streamRegistry.GetStreamInfo(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if nodes.IsLocal() {
+		return s.localGetEventProof(ctx, req)
+	}
+
+	return peerNodeRequestWithRetries(
+		ctx,
+		nodes,
+		s,
+		func(ctx context.Context, stub StreamServiceClient) (*connect.Response[GetEventProofResponse], error) {
+			ret, err := stub.GetEventProof(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(ret.Msg), nil
+		},
+		-1,
+	)
+}
+
+func (s *Service) DeleteStream(
+	ctx context.Context,
+	req *connect.Request[DeleteStreamRequest],
+) (*connect.Response[DeleteStreamResponse], error) {
+	ctx, log := utils.CtxAndLogForRequest(ctx, req)
+	log.Debug("DeleteStream ENTER", "req", req.Msg)
+	r, e := s.deleteStreamImpl(ctx, req)
+	if e != nil {
+		return nil, s.asAnnotatedRiverError(e).
+			Func("DeleteStream").
+			Tag("req.Msg.StreamId", req.Msg.StreamId).
+			LogWarn(log).
+			AsConnectError()
+	}
+	log.Debug("DeleteStream LEAVE", "response", r.Msg)
+	return r, nil
+}
+
+func (s *Service) deleteStreamImpl(
+	ctx context.Context,
+	req *connect.Request[DeleteStreamRequest],
+) (*connect.Response[DeleteStreamResponse], error) {
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := s.streamRegistry.GetStreamInfo(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if nodes.IsLocal() {
+		return s.localDeleteStream(ctx, req, nodes)
+	}
+
+	return peerNodeRequestWithRetries(
+		ctx,
+		nodes,
+		s,
+		func(ctx context.Context, stub StreamServiceClient) (*connect.Response[DeleteStreamResponse], error) {
+			ret, err := stub.DeleteStream(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(ret.Msg), nil
+		},
+		-1,
+	)
+}
+
 func (s *Service) AddEvent(
 	ctx context.Context,
 	req *connect.Request[AddEventRequest],
@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/events"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/shared"
+)
+
+func (s *Service) localGetEventProof(
+	ctx context.Context,
+	req *connect.Request[GetEventProofRequest],
+) (*connect.Response[GetEventProofResponse], error) {
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Msg.EventHash) != common.HashLength {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid event hash").Tag("eventHash", req.Msg.EventHash)
+	}
+
+	stream, err := s.cache.GetStream(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := FindEventProof(ctx, stream, common.BytesToHash(req.Msg.EventHash), req.Msg.MiniblockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(proof), nil
+}
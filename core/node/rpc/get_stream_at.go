@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"context"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/river-build/river/core/node/events"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/shared"
+)
+
+func (s *Service) localGetStreamAt(
+	ctx context.Context,
+	req *connect.Request[GetStreamAtRequest],
+) (*connect.Response[GetStreamAtResponse], error) {
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := s.cache.GetStream(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := LoadStreamViewAt(ctx, stream, req.Msg.MiniblockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := view.GetChannelMembers()
+	if err != nil {
+		return nil, err
+	}
+
+	pins, err := view.GetPinnedMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &GetStreamAtResponse{
+		Miniblocks: view.MiniblocksFromLastSnapshot(),
+		Pins:       pins,
+	}
+	memberIds := (*members).ToSlice()
+	slices.Sort(memberIds)
+	for _, member := range memberIds {
+		resp.Members = append(resp.Members, common.HexToAddress(member).Bytes())
+	}
+
+	return connect.NewResponse(resp), nil
+}
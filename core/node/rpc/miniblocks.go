@@ -58,6 +58,32 @@ func (s *Service) SaveMbCandidate(
 	return err
 }
 
+func (s *Service) GetLastMbRef(
+	ctx context.Context,
+	node common.Address,
+	streamId StreamId,
+) (*MiniblockRef, error) {
+	remote, err := s.nodeRegistry.GetStreamServiceClientForAddress(node)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := remote.GetLastMiniblockHash(
+		ctx,
+		connect.NewRequest(&GetLastMiniblockHashRequest{
+			StreamId: streamId[:],
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MiniblockRef{
+		Hash: common.BytesToHash(resp.Msg.Hash),
+		Num:  resp.Msg.MiniblockNum,
+	}, nil
+}
+
 // GetMiniBlocksStreamed returns a range of mini-blocks from the given stream.
 func (s *Service) GetMbsStreamed(
 	ctx context.Context,
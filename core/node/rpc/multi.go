@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptrace"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -349,6 +350,39 @@ func (s *Service) handleDebugStorage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// handleDebugMiniblockCandidates lists streams with at least "threshold" miniblock candidates,
+// if threshold is not given, CandidateGC.ExcessiveCandidates from the database config is used.
+func (s *Service) handleDebugMiniblockCandidates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := s.defaultLogger
+
+	threshold := 0
+	if v := r.URL.Query().Get("threshold"); v != "" {
+		var err error
+		threshold, err = strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "Bad threshold: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	streams, err := s.pgStorage.GetStreamsWithExcessiveCandidates(ctx, threshold)
+	if err != nil {
+		log.Error("Error reading miniblock candidates for debug/storage/candidates", "err", err)
+		http.Error(w, "Internal Server Error: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if threshold <= 0 {
+		threshold = s.config.Database.CandidateGC.GetExcessiveCandidates()
+	}
+	err = render.ExecuteAndWrite(&render.MiniblockCandidatesData{Threshold: threshold, Streams: streams}, w)
+	if err != nil {
+		log.Error("Error rendering template for debug/storage/candidates", "err", err)
+		http.Error(w, "Internal Server Error: "+err.Error(), http.StatusInternalServerError)
+	}
+}
+
 func (s *Service) handleDebugMulti(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	if s.otelTracer != nil {
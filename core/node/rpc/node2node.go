@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"time"
 
 	"github.com/river-build/river/core/node/utils"
 
@@ -102,6 +103,43 @@ func (s *Service) newEventReceived(
 	return &NewEventReceivedResponse{}, nil
 }
 
+func (s *Service) DeleteStreamReplica(
+	ctx context.Context,
+	req *connect.Request[DeleteStreamRequest],
+) (*connect.Response[DeleteStreamResponse], error) {
+	ctx, log := utils.CtxAndLogForRequest(ctx, req)
+	log.Debug("DeleteStreamReplica ENTER")
+	r, e := s.deleteStreamReplicaLocal(ctx, req.Msg)
+	if e != nil {
+		return nil, AsRiverError(
+			e,
+		).Func("DeleteStreamReplica").
+			Tag("streamId", req.Msg.StreamId).
+			LogWarn(log).
+			AsConnectError()
+	}
+	log.Debug("DeleteStreamReplica LEAVE", "response", r)
+	return connect.NewResponse(r), nil
+}
+
+func (s *Service) deleteStreamReplicaLocal(
+	ctx context.Context,
+	req *DeleteStreamRequest,
+) (*DeleteStreamResponse, error) {
+	// Replica doesn't trust the forwarding node and checks the request itself.
+	tombstone, err := checkDeleteStreamRequest(s.config.StreamDeletion, req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cache.DeleteStream(ctx, tombstone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteStreamResponse{DeletedOn: [][]byte{s.wallet.Address.Bytes()}}, nil
+}
+
 func (s *Service) NewEventInPool(
 	context.Context,
 	*connect.Request[NewEventInPoolRequest],
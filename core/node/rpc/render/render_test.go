@@ -2,11 +2,15 @@ package render_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/river-build/river/core/node/rpc/render"
 	"github.com/river-build/river/core/node/rpc/statusinfo"
+	"github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+	"github.com/river-build/river/core/node/testutils"
 )
 
 // implicitly calls render.init that loads and parses all templates
@@ -92,3 +96,39 @@ func TestRenderDebugMulti(t *testing.T) {
 	_, err := render.Execute(&payload)
 	require.NoError(t, err)
 }
+
+func TestRenderDebugMiniblockCandidates(t *testing.T) {
+	payload := render.MiniblockCandidatesData{
+		Threshold: 10,
+		Streams: []*storage.MiniblockCandidatesInfo{
+			{
+				StreamId:  testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN),
+				Count:     12,
+				MinSeqNum: 5,
+				MaxSeqNum: 9,
+				Oldest:    time.Now(),
+			},
+		},
+	}
+
+	_, err := render.Execute(&payload)
+	require.NoError(t, err)
+}
+
+func TestRenderDebugStorageUsage(t *testing.T) {
+	usage := &storage.StreamStorageUsage{
+		StreamId:     testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN),
+		StorageUsage: storage.StorageUsage{Miniblocks: 2, MiniblocksBytes: 100, MinipoolEvents: 1, MinipoolBytes: 10},
+	}
+	now := time.Now()
+	payload := render.StorageUsageData{
+		Report: storage.NewStorageUsageReport(
+			&storage.StorageUsageSample{Time: now, Streams: []*storage.StreamStorageUsage{usage}},
+			&storage.StorageUsageSample{Time: now.Add(-time.Minute)},
+			10,
+		),
+	}
+
+	_, err := render.Execute(&payload)
+	require.NoError(t, err)
+}
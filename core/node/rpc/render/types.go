@@ -9,7 +9,8 @@ import (
 // RenderableData is the interface for all data that can be rendered
 type RenderableData interface {
 	*AvailableDebugHandlersData | *CacheData | *TransactionPoolData | *OnChainConfigData |
-		*GoRoutineData | *SystemStatsData | *InfoIndexData | *DebugMultiData | *StorageData
+		*GoRoutineData | *SystemStatsData | *InfoIndexData | *DebugMultiData | *StorageData |
+		*MiniblockCandidatesData | *StorageUsageData
 
 	// TemplateName returns the name of the template to be used for rendering
 	TemplateName() string
@@ -129,3 +130,20 @@ type OnChainConfigData struct {
 func (d OnChainConfigData) TemplateName() string {
 	return "templates/debug/on-chain-config.template.html"
 }
+
+type MiniblockCandidatesData struct {
+	Threshold int
+	Streams   []*storage.MiniblockCandidatesInfo
+}
+
+func (d MiniblockCandidatesData) TemplateName() string {
+	return "templates/debug/candidates.template.html"
+}
+
+type StorageUsageData struct {
+	Report *storage.StorageUsageReport
+}
+
+func (d StorageUsageData) TemplateName() string {
+	return "templates/debug/storage_usage.template.html"
+}
@@ -10,11 +10,20 @@ import (
 	"github.com/river-build/river/core/node/crypto"
 	"github.com/river-build/river/core/node/events"
 	"github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/storage"
 	"github.com/river-build/river/core/node/testutils"
 )
 
 func TestReplCreate(t *testing.T) {
-	tt := newServiceTester(t, serviceTesterOpts{numNodes: 5, replicationFactor: 5, start: true})
+	tt := newServiceTester(
+		t,
+		serviceTesterOpts{
+			numNodes:          5,
+			replicationFactor: 5,
+			start:             true,
+			storageType:       storage.StreamStorageTypeMemory,
+		},
+	)
 	ctx := tt.ctx
 	require := tt.require
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/river-build/river/core/node/crypto"
 	. "github.com/river-build/river/core/node/events"
 	. "github.com/river-build/river/core/node/nodes"
 	. "github.com/river-build/river/core/node/protocol"
@@ -18,27 +19,45 @@ type replicatedStream struct {
 	localStream AddableStream
 	nodes       StreamNodes
 	service     *Service
+	consistency crypto.WriteConsistency
 }
 
 var _ AddableStream = (*replicatedStream)(nil)
 
 func (r *replicatedStream) AddEvent(ctx context.Context, event *ParsedEvent) error {
+	_, err := r.addEvent(ctx, event)
+	return err
+}
+
+// addEvent adds the event to the local stream and to the remote replicas and waits for the number of
+// acknowledgements required by the write consistency. Returns the nodes that acknowledged the event.
+func (r *replicatedStream) addEvent(ctx context.Context, event *ParsedEvent) ([]common.Address, error) {
+	localNode := r.service.wallet.Address
 	numRemotes := r.nodes.NumRemotes()
 	// TODO: remove
 	if numRemotes == 0 {
-		return r.localStream.AddEvent(ctx, event)
+		if err := r.localStream.AddEvent(ctx, event); err != nil {
+			return nil, err
+		}
+		return []common.Address{localNode}, nil
 	}
 
-	sender := NewQuorumPool(numRemotes)
+	sender := NewQuorumPoolWithConsistency(numRemotes, r.consistency)
 
 	sender.GoLocal(func() error {
 		return r.localStream.AddEvent(ctx, event)
 	})
 
+	// Remotes that are not waited for are updated in the background after the request is completed.
+	remoteCtx := ctx
+	if r.consistency == crypto.WriteConsistencyLocalOnly {
+		remoteCtx = context.WithoutCancel(ctx)
+	}
+
 	if numRemotes > 0 {
 		streamId, err := shared.StreamIdFromString(r.streamId)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for _, n := range r.nodes.GetRemotes() {
 			sender.GoRemote(
@@ -49,7 +68,7 @@ func (r *replicatedStream) AddEvent(ctx context.Context, event *ParsedEvent) err
 						return err
 					}
 					_, err = stub.NewEventReceived(
-						ctx,
+						remoteCtx,
 						connect.NewRequest[NewEventReceivedRequest](
 							&NewEventReceivedRequest{
 								StreamId: streamId[:],
@@ -63,5 +82,9 @@ func (r *replicatedStream) AddEvent(ctx context.Context, event *ParsedEvent) err
 		}
 	}
 
-	return sender.Wait()
+	if err := sender.Wait(); err != nil {
+		return nil, err
+	}
+
+	return append([]common.Address{localNode}, sender.AcknowledgedRemotes()...), nil
 }
@@ -0,0 +1,159 @@
+package rpc
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/events"
+	"github.com/river-build/river/core/node/nodes"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/protocol/protocolconnect"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+	"github.com/river-build/river/core/node/testutils/mocks"
+)
+
+type fakeLocalStream struct {
+	received atomic.Int32
+}
+
+func (f *fakeLocalStream) AddEvent(context.Context, *ParsedEvent) error {
+	f.received.Add(1)
+	return nil
+}
+
+// faultyPeer is a fake replica that drops or delays NewEventReceived requests.
+type faultyPeer struct {
+	protocolconnect.NodeToNodeClient
+	drop     bool
+	delay    time.Duration
+	received atomic.Int32
+}
+
+func (p *faultyPeer) NewEventReceived(
+	ctx context.Context,
+	_ *connect.Request[NewEventReceivedRequest],
+) (*connect.Response[NewEventReceivedResponse], error) {
+	if p.drop {
+		return nil, RiverError(Err_UNAVAILABLE, "faultyPeer: dropped")
+	}
+	select {
+	case <-time.After(p.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	p.received.Add(1)
+	return connect.NewResponse(&NewEventReceivedResponse{}), nil
+}
+
+func makeTestReplicatedStream(
+	t *testing.T,
+	consistency crypto.WriteConsistency,
+	peers ...*faultyPeer,
+) (*replicatedStream, *fakeLocalStream, []common.Address) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(t, err)
+
+	nodeRegistry := &mocks.MockNodeRegistry{}
+	streamNodes := []common.Address{wallet.Address}
+	for _, peer := range peers {
+		addr := crypto.GetTestAddress()
+		nodeRegistry.On("GetNodeToNodeClientForAddress", addr).Return(peer, nil)
+		streamNodes = append(streamNodes, addr)
+	}
+
+	local := &fakeLocalStream{}
+	return &replicatedStream{
+		streamId:    testutils.FakeStreamId(STREAM_CHANNEL_BIN).String(),
+		localStream: local,
+		nodes:       nodes.NewStreamNodes(streamNodes, wallet.Address),
+		service:     &Service{wallet: wallet, nodeRegistry: nodeRegistry},
+		consistency: consistency,
+	}, local, streamNodes
+}
+
+func TestReplicatedAddMajority(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+	event := &ParsedEvent{Envelope: &Envelope{}}
+
+	// One of two replicas drops the event, majority is still reached.
+	stream, local, streamNodes := makeTestReplicatedStream(
+		t, crypto.WriteConsistencyMajority, &faultyPeer{drop: true}, &faultyPeer{},
+	)
+	acks, err := stream.addEvent(ctx, event)
+	require.NoError(err)
+	require.ElementsMatch([]common.Address{streamNodes[0], streamNodes[2]}, acks)
+	require.EqualValues(1, local.received.Load())
+
+	// Slow replica is not waited for.
+	slow := &faultyPeer{delay: 10 * time.Second}
+	stream, _, streamNodes = makeTestReplicatedStream(t, crypto.WriteConsistencyMajority, slow, &faultyPeer{})
+	start := time.Now()
+	acks, err = stream.addEvent(ctx, event)
+	require.NoError(err)
+	require.Less(time.Since(start), 5*time.Second)
+	require.ElementsMatch([]common.Address{streamNodes[0], streamNodes[2]}, acks)
+
+	// Both replicas drop the event.
+	stream, _, _ = makeTestReplicatedStream(
+		t, crypto.WriteConsistencyMajority, &faultyPeer{drop: true}, &faultyPeer{drop: true},
+	)
+	_, err = stream.addEvent(ctx, event)
+	require.Equal(Err_UNAVAILABLE, AsRiverError(err).Code)
+}
+
+func TestReplicatedAddAll(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+	event := &ParsedEvent{Envelope: &Envelope{}}
+
+	// Delayed replica is waited for.
+	stream, _, streamNodes := makeTestReplicatedStream(
+		t, crypto.WriteConsistencyAll, &faultyPeer{delay: 100 * time.Millisecond}, &faultyPeer{},
+	)
+	acks, err := stream.addEvent(ctx, event)
+	require.NoError(err)
+	require.ElementsMatch(streamNodes, acks)
+
+	// Any dropped replica fails the write.
+	stream, _, _ = makeTestReplicatedStream(t, crypto.WriteConsistencyAll, &faultyPeer{drop: true}, &faultyPeer{})
+	_, err = stream.addEvent(ctx, event)
+	require.Equal(Err_UNAVAILABLE, AsRiverError(err).Code)
+}
+
+func TestReplicatedAddLocalOnly(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+	event := &ParsedEvent{Envelope: &Envelope{}}
+
+	slow := &faultyPeer{delay: 200 * time.Millisecond}
+	stream, local, streamNodes := makeTestReplicatedStream(
+		t, crypto.WriteConsistencyLocalOnly, slow, &faultyPeer{drop: true},
+	)
+
+	reqCtx, reqCancel := context.WithCancel(ctx)
+	acks, err := stream.addEvent(reqCtx, event)
+	reqCancel()
+	require.NoError(err)
+	require.Equal([]common.Address{streamNodes[0]}, acks)
+	require.EqualValues(1, local.received.Load())
+
+	// Replica is still updated in the background after the request is completed.
+	require.Eventually(func() bool { return slow.received.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+}
@@ -15,6 +15,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/cors"
 	"golang.org/x/net/http2"
@@ -41,6 +42,7 @@ const (
 	ServerModeFull    = "full"
 	ServerModeInfo    = "info"
 	ServerModeArchive = "archive"
+	ServerModeStorage = "storage"
 )
 
 func (s *Service) httpServerClose() {
@@ -165,6 +167,11 @@ func (s *Service) start() error {
 		return AsRiverError(err).Message("Failed to init store").LogError(s.defaultLogger)
 	}
 
+	err = s.initCdc()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to init cdc feed").LogError(s.defaultLogger)
+	}
+
 	err = s.initCacheAndSync()
 	if err != nil {
 		return AsRiverError(err).Message("Failed to init cache and sync").LogError(s.defaultLogger)
@@ -349,7 +356,7 @@ func (s *Service) prepareStore() error {
 	case storage.StreamStorageTypePostgres:
 		var schema string
 		switch s.mode {
-		case ServerModeFull:
+		case ServerModeFull, ServerModeStorage:
 			schema = storage.DbSchemaNameFromAddress(s.wallet.Address.Hex())
 		case ServerModeArchive:
 			schema = storage.DbSchemaNameForArchive(s.config.Archive.ArchiveId)
@@ -368,6 +375,9 @@ func (s *Service) prepareStore() error {
 		}
 		s.storagePoolInfo = pool
 
+		return nil
+	case storage.StreamStorageTypeLevelDb, storage.StreamStorageTypeMemory:
+		// Embedded storage is opened in initStore, there is no connection pool to prepare.
 		return nil
 	default:
 		return RiverError(
@@ -523,17 +533,24 @@ func (s *Service) initStore() error {
 
 	switch s.config.StorageType {
 	case storage.StreamStorageTypePostgres:
-		store, err := storage.NewPostgresStreamStore(
-			ctx,
-			s.storagePoolInfo,
-			s.instanceId,
-			s.exitSignal,
-			s.metrics,
-		)
+		var store *storage.PostgresStreamStore
+		var err error
+		if s.readOnlyStorage {
+			store, err = storage.NewReadOnlyPostgresStreamStore(ctx, s.storagePoolInfo, s.metrics)
+		} else {
+			store, err = storage.NewPostgresStreamStore(
+				ctx,
+				s.storagePoolInfo,
+				s.instanceId,
+				s.exitSignal,
+				s.metrics,
+			)
+		}
 		if err != nil {
 			return err
 		}
 		s.storage = store
+		s.pgStorage = store
 		s.onClose(store.Close)
 
 		streamsCount, err := store.GetStreamsNumber(ctx)
@@ -551,6 +568,36 @@ func (s *Service) initStore() error {
 			)
 		}
 		return nil
+	case storage.StreamStorageTypeLevelDb:
+		store, err := storage.NewLevelDbStreamStore(ctx, &s.config.EmbeddedStorage, s.metrics)
+		if err != nil {
+			return err
+		}
+		s.storage = store
+		s.onClose(store.Close)
+
+		streamsCount, err := store.GetStreamsNumber(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !s.config.Log.Simplify {
+			log.Info(
+				"Created embedded event store",
+				"dataDir",
+				s.config.EmbeddedStorage.DataDir,
+				"totalStreamsCount",
+				streamsCount,
+			)
+		}
+		return nil
+	case storage.StreamStorageTypeMemory:
+		// Data is lost on restart, this is intended for tests and ephemeral nodes only.
+		s.storage = storage.NewMemoryStreamStore()
+		if !s.config.Log.Simplify {
+			log.Info("Created in-memory event store")
+		}
+		return nil
 	default:
 		return RiverError(
 			Err_BAD_CONFIG,
@@ -561,6 +608,32 @@ func (s *Service) initStore() error {
 	}
 }
 
+// initCdc wraps storage to publish committed stream writes to the configured cdc sink.
+func (s *Service) initCdc() error {
+	cfg := &s.config.Cdc
+	if cfg.Sink == "" {
+		return nil
+	}
+
+	var pool *pgxpool.Pool
+	if s.config.StorageType == storage.StreamStorageTypePostgres && s.storagePoolInfo != nil {
+		pool = s.storagePoolInfo.Pool
+	}
+	sink, err := storage.NewCdcSink(cfg, pool)
+	if err != nil {
+		return err
+	}
+
+	store := storage.NewCdcStreamStorage(s.serverCtx, s.storage, cfg, sink, s.metrics)
+	s.storage = store
+	s.onClose(store.Close)
+
+	if !s.config.Log.Simplify {
+		s.defaultLogger.Info("Publishing stream writes to cdc sink", "sink", cfg.Sink)
+	}
+	return nil
+}
+
 func (s *Service) initCacheAndSync() error {
 	var err error
 	s.cache, err = events.NewStreamCache(
@@ -583,6 +656,7 @@ func (s *Service) initCacheAndSync() error {
 	}
 
 	s.mbProducer = events.NewMiniblockProducer(s.serverCtx, s.cache, nil)
+	s.ephemeralLimiter = newEphemeralEventLimiter(s.config.EphemeralEvents)
 
 	s.syncHandler = sync.NewHandler(
 		s.wallet.Address,
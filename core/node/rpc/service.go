@@ -44,12 +44,17 @@ type Service struct {
 	// Storage
 	storagePoolInfo *storage.PgxPoolInfo
 	storage         storage.StreamStorage
+	// pgStorage is set if storage type is postgres, it's used by postgres-specific debug endpoints.
+	pgStorage *storage.PostgresStreamStore
+	// readOnlyStorage is set by offline storage tools that only read the storage.
+	readOnlyStorage bool
 
 	// Streams
 	cache              events.StreamCache
 	mbProducer         events.MiniblockProducer
 	syncHandler        river_sync.Handler
 	scrubTaskProcessor scrub.StreamScrubTaskProcessor
+	ephemeralLimiter   *ephemeralEventLimiter
 
 	// River chain
 	riverChain       *crypto.Blockchain
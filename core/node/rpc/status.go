@@ -163,9 +163,29 @@ func (s *Service) getStatusResponse(ctx context.Context, url *url.URL) (*statusi
 		addr = s.wallet.Address.Hex()
 	}
 	statusStr := s.GetStatus()
+	// Stream cache is initialized before status is set to OK.
+	started := statusStr == "OK"
 	if status != http.StatusOK {
 		statusStr = "UNAVAILABLE"
 	}
+
+	var warmup *statusinfo.CacheWarmup
+	if started && s.cache != nil {
+		if p := s.cache.WarmupProgress(); p != nil {
+			warmup = &statusinfo.CacheWarmup{
+				Total:     p.Total,
+				Processed: p.Processed,
+				Loaded:    p.Loaded,
+				Done:      p.Done,
+				Elapsed:   p.Elapsed.String(),
+			}
+			// Node is not ready until hot streams are loaded.
+			if !p.Done && statusStr == "OK" {
+				statusStr = "WARMING_UP"
+				status = http.StatusServiceUnavailable
+			}
+		}
+	}
 	return &statusinfo.StatusResponse{
 		Status:            statusStr,
 		InstanceId:        s.instanceId,
@@ -178,6 +198,7 @@ func (s *Service) getStatusResponse(ctx context.Context, url *url.URL) (*statusi
 		Base:              basePing,
 		OtherChains:       otherChainsPing,
 		XChainBlockchains: s.chainConfig.Get().XChain.Blockchains,
+		CacheWarmup:       warmup,
 	}, status
 }
 
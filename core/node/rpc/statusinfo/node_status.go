@@ -25,6 +25,17 @@ type StatusResponse struct {
 	Base              *BlockchainPing  `json:"base,omitempty"`
 	OtherChains       []BlockchainPing `json:"other_chains,omitempty"`
 	XChainBlockchains []uint64         `json:"x_chain_blockchains"`
+	CacheWarmup       *CacheWarmup     `json:"cache_warmup,omitempty"`
+}
+
+// CacheWarmup reports preloading of stream views on startup.
+// While warmup is in progress, node status is WARMING_UP.
+type CacheWarmup struct {
+	Total     int    `json:"total"`
+	Processed int    `json:"processed"`
+	Loaded    int    `json:"loaded"`
+	Done      bool   `json:"done"`
+	Elapsed   string `json:"elapsed"`
 }
 
 func StatusResponseFromJson(data []byte) (StatusResponse, error) {
@@ -0,0 +1,74 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+)
+
+// startStorageMode initializes only the storage of the node for offline storage tools.
+// mode is ServerModeStorage to open the storage of the stream node, or ServerModeArchive
+// to open the storage of the archive node, which doesn't require a wallet.
+func (s *Service) startStorageMode(mode string, checkRegistry bool) error {
+	var err error
+	s.startTime = time.Now()
+
+	s.initInstance(mode)
+
+	if mode != ServerModeArchive {
+		err = s.initWallet()
+		if err != nil {
+			return AsRiverError(err).Message("Failed to init wallet").LogError(s.defaultLogger)
+		}
+	}
+
+	if checkRegistry {
+		err = s.initRiverChain()
+		if err != nil {
+			return AsRiverError(err).Message("Failed to init river chain").LogError(s.defaultLogger)
+		}
+	}
+
+	err = s.prepareStore()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to prepare store").LogError(s.defaultLogger)
+	}
+
+	err = s.initStore()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to init store").LogError(s.defaultLogger)
+	}
+
+	return nil
+}
+
+// runWithStorage opens the storage of the node configured by cfg, calls fn and closes the storage.
+// If readOnly is set, postgres storage is opened without taking it over from the running node,
+// otherwise the running node exits.
+func runWithStorage(
+	ctx context.Context,
+	cfg *config.Config,
+	mode string,
+	checkRegistry bool,
+	readOnly bool,
+	fn func(s *Service) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	service := &Service{
+		serverCtx:       ctx,
+		config:          cfg,
+		exitSignal:      make(chan error, 1),
+		readOnlyStorage: readOnly,
+	}
+	defer service.Close()
+
+	err := service.startStorageMode(mode, checkRegistry)
+	if err != nil {
+		return err
+	}
+	return fn(service)
+}
@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/river-build/river/core/config"
+	"github.com/river-build/river/core/node/storage"
+)
+
+// RunStorageUsage opens storage of the node configured by cfg and reports topN largest streams of each type.
+// If interval is positive, storage usage is sampled twice interval apart and the fastest growing streams
+// are reported as well. Running node reports the same data on /debug/storage/usage.
+func RunStorageUsage(
+	ctx context.Context,
+	cfg *config.Config,
+	topN int,
+	interval time.Duration,
+) (*storage.StorageUsageReport, error) {
+	var report *storage.StorageUsageReport
+	err := runWithStorage(ctx, cfg, ServerModeStorage, false, true, func(s *Service) error {
+		sample := func() (*storage.StorageUsageSample, error) {
+			streams, err := s.storage.GetStreamsStorageUsage(s.serverCtx)
+			if err != nil {
+				return nil, err
+			}
+			return &storage.StorageUsageSample{Time: time.Now(), Streams: streams}, nil
+		}
+
+		current, err := sample()
+		if err != nil {
+			return err
+		}
+
+		var previous *storage.StorageUsageSample
+		if interval > 0 {
+			select {
+			case <-time.After(interval):
+			case <-s.serverCtx.Done():
+				return s.serverCtx.Err()
+			}
+			previous = current
+			current, err = sample()
+			if err != nil {
+				return err
+			}
+		}
+
+		report = storage.NewStorageUsageReport(current, previous, topN)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
@@ -0,0 +1,32 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/river-build/river/core/config"
+	"github.com/river-build/river/core/node/events"
+)
+
+// RunStorageVerify opens storage of the node configured by cfg and verifies streams in it,
+// see events.VerifyStorage for the list of checks.
+// If checkRegistry is set, last miniblocks are compared with River registry records.
+func RunStorageVerify(
+	ctx context.Context,
+	cfg *config.Config,
+	opts events.StorageVerifyOpts,
+	checkRegistry bool,
+) (*events.StorageVerifyReport, error) {
+	var report *events.StorageVerifyReport
+	err := runWithStorage(ctx, cfg, ServerModeStorage, checkRegistry, true, func(s *Service) error {
+		if checkRegistry {
+			opts.Registry = s.registryContract
+		}
+		var err error
+		report, err = events.VerifyStorage(s.serverCtx, s.storage, &opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
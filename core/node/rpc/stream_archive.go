@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"context"
+	"io"
+
+	"github.com/river-build/river/core/config"
+	"github.com/river-build/river/core/node/events"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// RunStreamExport opens storage of the node configured by cfg and writes archive of the given stream to w.
+func RunStreamExport(ctx context.Context, cfg *config.Config, streamId StreamId, w io.Writer) error {
+	return runWithStorage(ctx, cfg, ServerModeStorage, false, true, func(s *Service) error {
+		archive, err := events.ExportStream(s.serverCtx, s.storage, streamId)
+		if err != nil {
+			return err
+		}
+		archive.Metadata.ExportedBy = s.wallet.Address.Hex()
+		return archive.Write(w)
+	})
+}
+
+// RunStreamImport opens storage of the node configured by cfg, validates the stream archive
+// and writes the stream into storage. If opts.Archive is set, storage of the archive node is used.
+func RunStreamImport(
+	ctx context.Context,
+	cfg *config.Config,
+	archive *events.StreamArchive,
+	opts *events.StreamImportOpts,
+) (StreamId, error) {
+	mode := ServerModeStorage
+	if opts.Archive {
+		mode = ServerModeArchive
+	}
+
+	var streamId StreamId
+	err := runWithStorage(ctx, cfg, mode, false, false, func(s *Service) error {
+		var err error
+		streamId, err = events.ImportStream(s.serverCtx, s.storage, archive, opts)
+		return err
+	})
+	if err != nil {
+		return StreamId{}, err
+	}
+	return streamId, nil
+}
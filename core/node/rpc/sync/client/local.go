@@ -50,6 +50,19 @@ func newLocalSyncer(
 
 func (s *localSyncer) Run() {
 	log := dlog.FromCtx(s.syncStreamCtx)
+
+	// Load views of all requested streams with a single storage read
+	// instead of loading them one by one on subscription.
+	if len(s.cookies) > 1 {
+		streamIDs := make([]StreamId, 0, len(s.cookies))
+		for _, cookie := range s.cookies {
+			if streamID, err := StreamIdFromBytes(cookie.GetStreamId()); err == nil {
+				streamIDs = append(streamIDs, streamID)
+			}
+		}
+		s.streamCache.LoadStreams(s.syncStreamCtx, streamIDs)
+	}
+
 	for _, cookie := range s.cookies {
 		streamID, _ := StreamIdFromBytes(cookie.GetStreamId())
 		if err := s.addStream(s.syncStreamCtx, streamID, cookie); err != nil {
@@ -141,6 +154,34 @@ func (s *localSyncer) OnStreamSyncDown(streamID StreamId) {
 	}
 }
 
+// OnStreamSyncError is called when updates for a stream won't be given anymore.
+// The client receives the stream down message with the error code and must not re-add the stream.
+func (s *localSyncer) OnStreamSyncError(streamID StreamId, err error) {
+	s.activeStreamsMu.Lock()
+	delete(s.activeStreams, streamID)
+	s.activeStreamsMu.Unlock()
+
+	msg := &SyncStreamsResponse{
+		SyncOp:    SyncOp_SYNC_DOWN,
+		StreamId:  streamID[:],
+		ErrorCode: AsRiverError(err).Code,
+	}
+	select {
+	case s.messages <- msg:
+		return
+	case <-s.syncStreamCtx.Done():
+		return
+	default:
+		err := RiverError(Err_BUFFER_FULL, "Client sync subscription message channel is full").
+			Tag("syncId", s.globalSyncOpID).
+			Func("OnStreamSyncError")
+
+		_ = err.LogError(dlog.FromCtx(s.syncStreamCtx))
+
+		s.cancelGlobalSyncOp(err)
+	}
+}
+
 func (s *localSyncer) addStream(ctx context.Context, streamID StreamId, cookie *SyncCookie) error {
 	s.activeStreamsMu.Lock()
 	defer s.activeStreamsMu.Unlock()
@@ -41,7 +41,7 @@ func (n *testNodeRecord) Close(ctx context.Context, dbUrl string) {
 		n.service.Close()
 		n.service = nil
 	}
-	if n.address != (common.Address{}) {
+	if n.address != (common.Address{}) && dbUrl != "" {
 		_ = dbtestutils.DeleteTestSchema(
 			ctx,
 			dbUrl,
@@ -64,6 +64,9 @@ type serviceTesterOpts struct {
 	numNodes          int
 	replicationFactor int
 	start             bool
+	// storageType defaults to postgres, set to storage.StreamStorageTypeMemory
+	// for tests that don't restart nodes.
+	storageType string
 }
 
 func newServiceTester(t *testing.T, opts serviceTesterOpts) *serviceTester {
@@ -77,16 +80,25 @@ func newServiceTester(t *testing.T, opts serviceTesterOpts) *serviceTester {
 		opts.replicationFactor = 1
 	}
 
+	if opts.storageType == "" {
+		opts.storageType = storage.StreamStorageTypePostgres
+	}
+
 	ctx, ctxCancel := test.NewTestContext()
 	t.Cleanup(ctxCancel)
 
 	require := require.New(t)
 
+	var dbUrl string
+	if opts.storageType == storage.StreamStorageTypePostgres {
+		dbUrl = dbtestutils.GetTestDbUrl()
+	}
+
 	st := &serviceTester{
 		ctx:     ctx,
 		t:       t,
 		require: require,
-		dbUrl:   dbtestutils.GetTestDbUrl(),
+		dbUrl:   dbUrl,
 		nodes:   make([]*testNodeRecord, opts.numNodes),
 		opts:    opts,
 	}
@@ -226,7 +238,7 @@ func (st *serviceTester) getConfig(opts ...startOpts) *config.Config {
 			NumPartitions:         4,
 			MigrateStreamCreation: true,
 		},
-		StorageType: "postgres",
+		StorageType: st.opts.storageType,
 		Network: config.NetworkConfig{
 			NumRetries: 3,
 		},
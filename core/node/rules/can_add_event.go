@@ -153,6 +153,14 @@ func CanAddEvent(
 	if err != nil {
 		return false, nil, nil, err
 	}
+	if parsedEvent.Event.Ephemeral && !isEphemeralPayload(parsedEvent.Event) {
+		return false, nil, nil, RiverError(
+			Err_INVALID_ARGUMENT,
+			"payload can't be ephemeral",
+			"event",
+			parsedEvent.ShortDebugStr(),
+		)
+	}
 
 	settings := chainConfig.Get()
 
@@ -171,6 +179,21 @@ func CanAddEvent(
 	return builder.run()
 }
 
+// isEphemeralPayload returns true if the event payload doesn't change stream state,
+// so the event can be delivered without being persisted.
+func isEphemeralPayload(event *StreamEvent) bool {
+	switch event.GetPayload().(type) {
+	case *StreamEvent_ChannelPayload:
+		return event.GetChannelPayload().GetMessage() != nil
+	case *StreamEvent_DmChannelPayload:
+		return event.GetDmChannelPayload().GetMessage() != nil
+	case *StreamEvent_GdmChannelPayload:
+		return event.GetGdmChannelPayload().GetMessage() != nil
+	default:
+		return false
+	}
+}
+
 func (params *aeParams) canAddEvent() ruleBuilderAE {
 	// run checks per payload type
 	switch payload := params.parsedEvent.Event.Payload.(type) {
@@ -513,6 +536,20 @@ func (params *aeParams) canAddMemberPayload(payload *StreamEvent_MemberPayload)
 				check(params.creatorIsMember).
 				check(unpinRules.validUnpin)
 		}
+	case *MemberPayload_Seal_:
+		if shared.ValidSpaceStreamId(params.streamView.StreamId()) {
+			return aeBuilder().
+				check(params.creatorIsMember).
+				requireChainAuth(params.spaceEntitlements(auth.PermissionModifySpaceSettings))
+		} else if shared.ValidChannelStreamId(params.streamView.StreamId()) {
+			return aeBuilder().
+				check(params.creatorIsMember).
+				requireChainAuth(params.channelSpaceEntitlements(auth.PermissionAddRemoveChannels))
+		} else {
+			return aeBuilder().
+				fail(RiverError(Err_INVALID_ARGUMENT, "only space and channel streams can be sealed",
+					"streamId", params.streamView.StreamId()))
+		}
 	default:
 		return aeBuilder().
 			fail(unknownContentType(content))
@@ -1043,6 +1080,28 @@ func (params *aeParams) channelEntitlements(permission auth.Permission) func() (
 	}
 }
 
+// return a function that can be used to check if a user has a permission in the space of a channel
+func (params *aeParams) channelSpaceEntitlements(permission auth.Permission) func() (*auth.ChainAuthArgs, error) {
+	return func() (*auth.ChainAuthArgs, error) {
+		userId, err := shared.AddressHex(params.parsedEvent.Event.CreatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		inception, err := params.streamView.(events.ChannelStreamView).GetChannelInception()
+		if err != nil {
+			return nil, err
+		}
+
+		spaceId, err := shared.StreamIdFromBytes(inception.SpaceId)
+		if err != nil {
+			return nil, err
+		}
+
+		return auth.NewChainAuthArgsForSpace(spaceId, userId, permission), nil
+	}
+}
+
 func (params *aeParams) onEntitlementFailureForUserEvent() (*DerivedEvent, error) {
 	userId, err := shared.AddressHex(params.parsedEvent.Event.CreatorAddress)
 	if err != nil {
@@ -0,0 +1,127 @@
+package rules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/river-build/river/core/contracts/river"
+	"github.com/river-build/river/core/node/auth"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/events"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+type testOnChainConfig struct {
+	settings *crypto.OnChainSettings
+}
+
+func (c *testOnChainConfig) ActiveBlock() crypto.BlockNumber { return 0 }
+
+func (c *testOnChainConfig) Get() *crypto.OnChainSettings { return c.settings }
+
+func (c *testOnChainConfig) GetOnBlock(crypto.BlockNumber) *crypto.OnChainSettings { return c.settings }
+
+func (c *testOnChainConfig) All() []*crypto.OnChainSettings {
+	return []*crypto.OnChainSettings{c.settings}
+}
+
+func (c *testOnChainConfig) LastAppliedEvent() *river.RiverConfigV1ConfigurationChanged { return nil }
+
+// makeTestStreamView creates view of the stream with the genesis miniblock made of the given payloads.
+func makeTestStreamView(
+	t *testing.T,
+	ctx context.Context,
+	wallet *crypto.Wallet,
+	payloads ...IsStreamEvent_Payload,
+) StreamView {
+	var events []*ParsedEvent
+	for _, payload := range payloads {
+		event, err := MakeParsedEventWithPayload(wallet, payload, &MiniblockRef{})
+		require.NoError(t, err)
+		events = append(events, event)
+	}
+	mb, err := MakeGenesisMiniblock(wallet, events)
+	require.NoError(t, err)
+	mbBytes, err := proto.Marshal(mb)
+	require.NoError(t, err)
+	view, err := MakeStreamView(ctx, &storage.ReadStreamFromLastSnapshotResult{Miniblocks: [][]byte{mbBytes}})
+	require.NoError(t, err)
+	return view
+}
+
+func TestCanAddSealEvent(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	require := require.New(t)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	nodeWallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	user, err := AddressHex(wallet.Address.Bytes())
+	require.NoError(err)
+	chainConfig := &testOnChainConfig{settings: crypto.DefaultOnChainSettings()}
+	validNodes := []common.Address{nodeWallet.Address}
+
+	canAddSeal := func(view StreamView, wallet *crypto.Wallet) (bool, []*auth.ChainAuthArgs, error) {
+		seal, err := MakeParsedEventWithPayload(wallet, Make_MemberPayload_Seal("deleted"), view.LastBlock().Ref)
+		require.NoError(err)
+		canAdd, chainAuthArgs, _, err := CanAddEvent(ctx, chainConfig, validNodes, time.Now(), seal, view)
+		return canAdd, chainAuthArgs, err
+	}
+
+	spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	spaceView := makeTestStreamView(
+		t, ctx, wallet,
+		Make_SpacePayload_Inception(spaceId, nil),
+		Make_SpacePayload_Membership(MembershipOp_SO_JOIN, user, user),
+	)
+	canAdd, chainAuthArgs, err := canAddSeal(spaceView, wallet)
+	require.NoError(err)
+	require.True(canAdd)
+	require.Equal(
+		[]*auth.ChainAuthArgs{auth.NewChainAuthArgsForSpace(spaceId, user, auth.PermissionModifySpaceSettings)},
+		chainAuthArgs,
+	)
+
+	channelId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	channelView := makeTestStreamView(
+		t, ctx, wallet,
+		Make_ChannelPayload_Inception(channelId, spaceId, nil),
+		Make_ChannelPayload_Membership(MembershipOp_SO_JOIN, user, user, &spaceId),
+	)
+	canAdd, chainAuthArgs, err = canAddSeal(channelView, wallet)
+	require.NoError(err)
+	require.True(canAdd)
+	require.Equal(
+		[]*auth.ChainAuthArgs{auth.NewChainAuthArgsForSpace(spaceId, user, auth.PermissionAddRemoveChannels)},
+		chainAuthArgs,
+	)
+
+	// Only members can seal.
+	otherWallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	_, _, err = canAddSeal(channelView, otherWallet)
+	require.Equal(Err_PERMISSION_DENIED, AsRiverError(err).Code)
+
+	// Other streams can't be sealed, not even by nodes.
+	settingsView := makeTestStreamView(
+		t, ctx, wallet,
+		Make_UserSettingsPayload_Inception(UserSettingStreamIdFromAddr(wallet.Address), nil),
+	)
+	for _, w := range []*crypto.Wallet{wallet, nodeWallet} {
+		canAdd, _, err = canAddSeal(settingsView, w)
+		require.False(canAdd)
+		require.Equal(Err_INVALID_ARGUMENT, AsRiverError(err).Code)
+	}
+}
@@ -3,6 +3,7 @@ package shared
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -161,3 +162,13 @@ func (id *StreamId) ScanText(v pgtype.Text) error {
 func (id StreamId) MarshalJSON() ([]byte, error) {
 	return []byte("\"" + id.String() + "\""), nil
 }
+
+func (id *StreamId) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	var err error
+	*id, err = StreamIdFromString(s)
+	return err
+}
@@ -0,0 +1,529 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+const (
+	defaultCdcQueueSize      = 10000
+	defaultCdcBatchSize      = 100
+	defaultCdcWebhookTimeout = 10 * time.Second
+	defaultCdcNotifyChannel  = "river_cdc"
+
+	// cdcFlushTimeout is how long Close waits for queued records to be delivered.
+	cdcFlushTimeout = 5 * time.Second
+
+	// maxPgNotifyPayload is the maximum size of NOTIFY payload in the default Postgres configuration.
+	maxPgNotifyPayload = 7999
+)
+
+type CdcRecordType string
+
+const (
+	CdcRecordMiniblock CdcRecordType = "miniblock"
+	CdcRecordEvent     CdcRecordType = "event"
+	// CdcRecordStreamDeleted tells consumers to remove all data of the stream.
+	CdcRecordStreamDeleted CdcRecordType = "stream_deleted"
+)
+
+// CdcRecord describes a single miniblock or minipool event committed to storage, or deletion of a stream.
+type CdcRecord struct {
+	// Seq is assigned sequentially to all records produced by the node since start.
+	// Gap in sequence numbers means that records were dropped because sink fell behind.
+	Seq      uint64        `json:"seq"`
+	Time     time.Time     `json:"time"`
+	Type     CdcRecordType `json:"type"`
+	StreamId StreamId      `json:"streamId"`
+	// Number is miniblock number for miniblocks and minipool generation for events.
+	Number int64 `json:"number"`
+	// Slot is the position of the event in the minipool, 0 for miniblocks.
+	Slot int         `json:"slot"`
+	Hash common.Hash `json:"hash"`
+	Data []byte      `json:"data,omitempty"`
+	// Truncated is set if Data is omitted since it doesn't fit into sink limits.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// CdcSink delivers records of the change-data-capture feed to external consumers.
+// Send is called from a single goroutine with records in sequence order.
+// If Send fails, it is retried with the same records.
+type CdcSink interface {
+	Send(ctx context.Context, records []*CdcRecord) error
+	Close()
+}
+
+// NewCdcSink creates the sink configured by cfg. pool is only required for the "pg_notify" sink.
+func NewCdcSink(cfg *config.CdcConfig, pool *pgxpool.Pool) (CdcSink, error) {
+	switch cfg.Sink {
+	case config.CdcSinkFile:
+		if cfg.File == "" {
+			return nil, RiverError(Err_BAD_CONFIG, "Cdc file is not set").Func("NewCdcSink")
+		}
+		return &cdcFileSink{path: cfg.File}, nil
+	case config.CdcSinkWebhook:
+		if cfg.WebhookUrl == "" {
+			return nil, RiverError(Err_BAD_CONFIG, "Cdc webhook url is not set").Func("NewCdcSink")
+		}
+		timeout := cfg.WebhookTimeout
+		if timeout <= 0 {
+			timeout = defaultCdcWebhookTimeout
+		}
+		return &cdcWebhookSink{url: cfg.WebhookUrl, client: &http.Client{Timeout: timeout}}, nil
+	case config.CdcSinkPgNotify:
+		if pool == nil {
+			return nil, RiverError(Err_BAD_CONFIG, "Cdc pg_notify sink requires postgres storage").
+				Func("NewCdcSink")
+		}
+		channel := cfg.NotifyChannel
+		if channel == "" {
+			channel = defaultCdcNotifyChannel
+		}
+		return &cdcPgNotifySink{pool: pool, channel: channel}, nil
+	default:
+		return nil, RiverError(Err_BAD_CONFIG, "Unknown cdc sink", "sink", cfg.Sink).Func("NewCdcSink")
+	}
+}
+
+// cdcFileSink appends records as JSON lines to a file or named pipe.
+// File is opened on first write and reopened after write errors, i.e. when pipe reader goes away.
+type cdcFileSink struct {
+	path string
+	file *os.File
+}
+
+func (s *cdcFileSink) Send(_ context.Context, records []*CdcRecord) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, r := range records {
+		if err := encoder.Encode(r); err != nil {
+			return err
+		}
+	}
+
+	if s.file == nil {
+		file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+		if err != nil {
+			return err
+		}
+		s.file = file
+	}
+
+	_, err := s.file.Write(buf.Bytes())
+	if err != nil {
+		s.Close()
+		return err
+	}
+	return nil
+}
+
+func (s *cdcFileSink) Close() {
+	if s.file != nil {
+		_ = s.file.Close()
+		s.file = nil
+	}
+}
+
+// cdcWebhookSink posts batches of records as JSON arrays.
+type cdcWebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func (s *cdcWebhookSink) Send(ctx context.Context, records []*CdcRecord) error {
+	body, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return RiverError(Err_UNAVAILABLE, "Cdc webhook request failed", "status", resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *cdcWebhookSink) Close() {
+	s.client.CloseIdleConnections()
+}
+
+// cdcPgNotifySink sends each record as a separate notification to the Postgres channel.
+// Records of a batch are sent in a single transaction, so listeners receive them together.
+// Payload size of NOTIFY is limited, data is omitted from records that don't fit.
+type cdcPgNotifySink struct {
+	pool    *pgxpool.Pool
+	channel string
+}
+
+func (s *cdcPgNotifySink) Send(ctx context.Context, records []*CdcRecord) error {
+	payloads := make([]string, 0, len(records))
+	for _, r := range records {
+		payload, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		if len(payload) > maxPgNotifyPayload {
+			truncated := *r
+			truncated.Data = nil
+			truncated.Truncated = true
+			payload, err = json.Marshal(&truncated)
+			if err != nil {
+				return err
+			}
+		}
+		payloads = append(payloads, string(payload))
+	}
+
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		for _, payload := range payloads {
+			if _, err := tx.Exec(ctx, "SELECT pg_notify($1, $2)", s.channel, payload); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *cdcPgNotifySink) Close() {}
+
+// cdcFeed assigns sequence numbers to records and delivers them to the sink in order.
+// Writes to storage are never blocked by the sink: if the queue is full, records are dropped.
+type cdcFeed struct {
+	sink      CdcSink
+	batchSize int
+
+	mu     sync.Mutex
+	seq    uint64
+	closed bool
+	queue  chan *CdcRecord
+
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	records    *prometheus.CounterVec
+	sinkErrors prometheus.Counter
+}
+
+func newCdcFeed(ctx context.Context, cfg *config.CdcConfig, sink CdcSink, metrics infra.MetricsFactory) *cdcFeed {
+	queueSize := cfg.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultCdcQueueSize
+	}
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultCdcBatchSize
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	f := &cdcFeed{
+		sink:      sink,
+		batchSize: batchSize,
+		queue:     make(chan *CdcRecord, queueSize),
+		cancel:    cancel,
+		done:      make(chan struct{}),
+		records: metrics.NewCounterVecEx(
+			"cdc_records",
+			"Records of the change-data-capture feed by delivery result",
+			"result",
+		),
+		sinkErrors: metrics.NewCounterEx("cdc_sink_errors", "Failed attempts to deliver records to the cdc sink"),
+	}
+	go f.run(ctx)
+	return f
+}
+
+func (f *cdcFeed) publish(records ...*CdcRecord) {
+	now := time.Now()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return
+	}
+	for _, r := range records {
+		f.seq++
+		r.Seq = f.seq
+		r.Time = now
+		select {
+		case f.queue <- r:
+		default:
+			f.records.WithLabelValues("dropped").Inc()
+		}
+	}
+}
+
+func (f *cdcFeed) run(ctx context.Context) {
+	defer close(f.done)
+
+	for {
+		var first *CdcRecord
+		select {
+		case <-ctx.Done():
+			return
+		case r, ok := <-f.queue:
+			if !ok {
+				return
+			}
+			first = r
+		}
+
+		batch := []*CdcRecord{first}
+	fill:
+		for len(batch) < f.batchSize {
+			select {
+			case r, ok := <-f.queue:
+				if !ok {
+					break fill
+				}
+				batch = append(batch, r)
+			default:
+				break fill
+			}
+		}
+
+		if !f.send(ctx, batch) {
+			return
+		}
+	}
+}
+
+// send delivers the batch retrying until it succeeds or ctx is canceled.
+func (f *cdcFeed) send(ctx context.Context, batch []*CdcRecord) bool {
+	bo := backoff.NewExponentialBackOff(
+		backoff.WithInitialInterval(100*time.Millisecond),
+		backoff.WithMaxInterval(30*time.Second),
+		backoff.WithMaxElapsedTime(0),
+	)
+	for {
+		err := f.sink.Send(ctx, batch)
+		if err == nil {
+			f.records.WithLabelValues("sent").Add(float64(len(batch)))
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+
+		f.sinkErrors.Inc()
+		dlog.FromCtx(ctx).Warn(
+			"cdcFeed: failed to send records",
+			"firstSeq", batch[0].Seq,
+			"numRecords", len(batch),
+			"error", err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(bo.NextBackOff()):
+		}
+	}
+}
+
+// close stops accepting records and waits for queued records to be delivered for up to cdcFlushTimeout.
+func (f *cdcFeed) close() {
+	f.mu.Lock()
+	if f.closed {
+		f.mu.Unlock()
+		return
+	}
+	f.closed = true
+	close(f.queue)
+	f.mu.Unlock()
+
+	select {
+	case <-f.done:
+	case <-time.After(cdcFlushTimeout):
+		f.cancel()
+		<-f.done
+	}
+	f.cancel()
+	f.sink.Close()
+}
+
+// cdcStreamStorage publishes records to the cdc feed after writes to the wrapped storage succeed.
+// Writes to the same stream are serialized by callers, so records of each stream are published
+// in the commit order. Miniblock candidates are not published, since they are not committed yet.
+type cdcStreamStorage struct {
+	StreamStorage
+	feed *cdcFeed
+}
+
+var _ StreamStorage = (*cdcStreamStorage)(nil)
+
+// NewCdcStreamStorage wraps the store to publish committed miniblocks and minipool events to the sink.
+// Closing returned storage flushes the feed and closes the sink, the wrapped store is closed by its owner.
+func NewCdcStreamStorage(
+	ctx context.Context,
+	store StreamStorage,
+	cfg *config.CdcConfig,
+	sink CdcSink,
+	metrics infra.MetricsFactory,
+) StreamStorage {
+	return &cdcStreamStorage{
+		StreamStorage: store,
+		feed:          newCdcFeed(ctx, cfg, sink, metrics),
+	}
+}
+
+// cdcMiniblockRecord returns record for the serialized miniblock.
+// Hash is taken from the miniblock header and is left empty if miniblock can't be parsed.
+func cdcMiniblockRecord(streamId StreamId, num int64, data []byte) *CdcRecord {
+	var mb Miniblock
+	var hash common.Hash
+	if err := proto.Unmarshal(data, &mb); err == nil {
+		hash = common.BytesToHash(mb.GetHeader().GetHash())
+	}
+	return &CdcRecord{
+		Type:     CdcRecordMiniblock,
+		StreamId: streamId,
+		Number:   num,
+		Hash:     hash,
+		Data:     data,
+	}
+}
+
+func (s *cdcStreamStorage) CreateStreamStorage(
+	ctx context.Context,
+	streamId StreamId,
+	genesisMiniblock []byte,
+) error {
+	err := s.StreamStorage.CreateStreamStorage(ctx, streamId, genesisMiniblock)
+	if err != nil {
+		return err
+	}
+	s.feed.publish(cdcMiniblockRecord(streamId, 0, genesisMiniblock))
+	return nil
+}
+
+func (s *cdcStreamStorage) WriteEvent(
+	ctx context.Context,
+	streamId StreamId,
+	minipoolGeneration int64,
+	minipoolSlot int,
+	envelope []byte,
+) error {
+	err := s.StreamStorage.WriteEvent(ctx, streamId, minipoolGeneration, minipoolSlot, envelope)
+	if err != nil {
+		return err
+	}
+
+	var env Envelope
+	var hash common.Hash
+	if err := proto.Unmarshal(envelope, &env); err == nil {
+		hash = common.BytesToHash(env.Hash)
+	}
+	s.feed.publish(&CdcRecord{
+		Type:     CdcRecordEvent,
+		StreamId: streamId,
+		Number:   minipoolGeneration,
+		Slot:     minipoolSlot,
+		Hash:     hash,
+		Data:     envelope,
+	})
+	return nil
+}
+
+// WriteMiniblocks publishes only new miniblocks: events of the new minipool were already
+// published when they were written to the previous minipool.
+func (s *cdcStreamStorage) WriteMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolGeneration int64,
+	newMinipoolEnvelopes [][]byte,
+	prevMinipoolGeneration int64,
+	prevMinipoolSize int,
+) error {
+	err := s.StreamStorage.WriteMiniblocks(
+		ctx,
+		streamId,
+		miniblocks,
+		newMinipoolGeneration,
+		newMinipoolEnvelopes,
+		prevMinipoolGeneration,
+		prevMinipoolSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	records := make([]*CdcRecord, 0, len(miniblocks))
+	for _, mb := range miniblocks {
+		records = append(records, &CdcRecord{
+			Type:     CdcRecordMiniblock,
+			StreamId: streamId,
+			Number:   mb.Number,
+			Hash:     mb.Hash,
+			Data:     mb.Data,
+		})
+	}
+	s.feed.publish(records...)
+	return nil
+}
+
+func (s *cdcStreamStorage) WriteArchiveMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	startMiniblockNum int64,
+	miniblocks [][]byte,
+) error {
+	err := s.StreamStorage.WriteArchiveMiniblocks(ctx, streamId, startMiniblockNum, miniblocks)
+	if err != nil {
+		return err
+	}
+
+	records := make([]*CdcRecord, 0, len(miniblocks))
+	for i, mb := range miniblocks {
+		records = append(records, cdcMiniblockRecord(streamId, startMiniblockNum+int64(i), mb))
+	}
+	s.feed.publish(records...)
+	return nil
+}
+
+func (s *cdcStreamStorage) DeleteStream(ctx context.Context, streamId StreamId) error {
+	err := s.StreamStorage.DeleteStream(ctx, streamId)
+	if err != nil {
+		return err
+	}
+	s.feed.publish(&CdcRecord{
+		Type:     CdcRecordStreamDeleted,
+		StreamId: streamId,
+	})
+	return nil
+}
+
+func (s *cdcStreamStorage) Close(ctx context.Context) {
+	s.feed.close()
+}
@@ -0,0 +1,200 @@
+package storage
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/river-build/river/core/config"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func readCdcFile(t *testing.T, path string) []*CdcRecord {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []*CdcRecord
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var r CdcRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+		records = append(records, &r)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestCdcStreamStorageFileSink(t *testing.T) {
+	require := require.New(t)
+	ctx, ctxCloser := test.NewTestContext()
+	defer ctxCloser()
+
+	cfg := &config.CdcConfig{Sink: config.CdcSinkFile, File: filepath.Join(t.TempDir(), "cdc.jsonl")}
+	sink, err := NewCdcSink(cfg, nil)
+	require.NoError(err)
+	store := NewCdcStreamStorage(ctx, NewMemoryStreamStore(), cfg, sink, infra.NewMetricsFactory(nil, "", ""))
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	genesisHash := common.HexToHash("0x01")
+	genesis, err := proto.Marshal(&Miniblock{Header: &Envelope{Hash: genesisHash[:]}})
+	require.NoError(err)
+	require.NoError(store.CreateStreamStorage(ctx, streamId, genesis))
+
+	eventHash := common.HexToHash("0x02")
+	event, err := proto.Marshal(&Envelope{Hash: eventHash[:]})
+	require.NoError(err)
+	require.NoError(store.WriteEvent(ctx, streamId, 1, 0, event))
+
+	// Failed writes are not published.
+	require.Error(store.WriteEvent(ctx, streamId, 5, 0, event))
+
+	mb := &WriteMiniblockData{Number: 1, Hash: common.HexToHash("0x03"), Data: []byte("miniblock1")}
+	require.NoError(store.WriteMiniblocks(ctx, streamId, []*WriteMiniblockData{mb}, 2, nil, 1, 1))
+
+	// Candidates are not committed and are not published.
+	require.NoError(store.WriteMiniblockCandidate(ctx, streamId, common.HexToHash("0x04"), 2, []byte("candidate")))
+
+	store.Close(ctx)
+
+	records := readCdcFile(t, cfg.File)
+	require.Len(records, 3)
+	for i, r := range records {
+		require.EqualValues(i+1, r.Seq)
+		require.Equal(streamId, r.StreamId)
+	}
+
+	require.Equal(CdcRecordMiniblock, records[0].Type)
+	require.EqualValues(0, records[0].Number)
+	require.Equal(genesisHash, records[0].Hash)
+	require.Equal(genesis, records[0].Data)
+
+	require.Equal(CdcRecordEvent, records[1].Type)
+	require.EqualValues(1, records[1].Number)
+	require.Equal(0, records[1].Slot)
+	require.Equal(eventHash, records[1].Hash)
+	require.Equal(event, records[1].Data)
+
+	require.Equal(CdcRecordMiniblock, records[2].Type)
+	require.EqualValues(1, records[2].Number)
+	require.Equal(mb.Hash, records[2].Hash)
+	require.Equal(mb.Data, records[2].Data)
+}
+
+func TestCdcWebhookSinkRetries(t *testing.T) {
+	require := require.New(t)
+	ctx, ctxCloser := test.NewTestContext()
+	defer ctxCloser()
+
+	var mu sync.Mutex
+	var requests int
+	var received []*CdcRecord
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var batch []*CdcRecord
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = append(received, batch...)
+	}))
+	defer server.Close()
+
+	cfg := &config.CdcConfig{Sink: config.CdcSinkWebhook, WebhookUrl: server.URL}
+	sink, err := NewCdcSink(cfg, nil)
+	require.NoError(err)
+	store := NewCdcStreamStorage(ctx, NewMemoryStreamStore(), cfg, sink, infra.NewMetricsFactory(nil, "", ""))
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(store.CreateStreamStorage(ctx, streamId, []byte("genesis")))
+	for i := 0; i < 5; i++ {
+		require.NoError(store.WriteEvent(ctx, streamId, 1, i, []byte("event")))
+	}
+
+	require.Eventually(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 6
+	}, 10*time.Second, 10*time.Millisecond)
+	store.Close(ctx)
+
+	for i, r := range received {
+		require.EqualValues(i+1, r.Seq)
+		if i > 0 {
+			require.Equal(CdcRecordEvent, r.Type)
+			require.Equal(i-1, r.Slot)
+		}
+	}
+}
+
+type blockingCdcSink struct {
+	release chan struct{}
+	mu      sync.Mutex
+	records []*CdcRecord
+}
+
+func (s *blockingCdcSink) Send(ctx context.Context, records []*CdcRecord) error {
+	select {
+	case <-s.release:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, records...)
+	return nil
+}
+
+func (s *blockingCdcSink) Close() {}
+
+func TestCdcFeedDropsWhenQueueIsFull(t *testing.T) {
+	require := require.New(t)
+	ctx, ctxCloser := test.NewTestContext()
+	defer ctxCloser()
+
+	sink := &blockingCdcSink{release: make(chan struct{})}
+	cfg := &config.CdcConfig{QueueSize: 2, BatchSize: 10}
+	store := NewCdcStreamStorage(ctx, NewMemoryStreamStore(), cfg, sink, infra.NewMetricsFactory(nil, "", ""))
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(store.CreateStreamStorage(ctx, streamId, []byte("genesis")))
+	// Wait for the first record to be taken by the blocked sink.
+	require.Eventually(func() bool {
+		return len(store.(*cdcStreamStorage).feed.queue) == 0
+	}, 10*time.Second, time.Millisecond)
+
+	// Writes are not blocked by the sink: records that don't fit into the queue are dropped.
+	for i := 0; i < 5; i++ {
+		require.NoError(store.WriteEvent(ctx, streamId, 1, i, []byte("event")))
+	}
+	close(sink.release)
+	store.Close(ctx)
+
+	var seqs []uint64
+	for _, r := range sink.records {
+		seqs = append(seqs, r.Seq)
+	}
+	require.Equal([]uint64{1, 2, 3}, seqs)
+}
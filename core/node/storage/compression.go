@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+const (
+	BlobCodecNone = "none"
+	BlobCodecZstd = "zstd"
+
+	defaultCompressionMinSize          = 64
+	defaultRecompressionBatchSize      = 100
+	blobCodecMarker               byte = 0x00
+	blobCodecIdRaw                byte = 0x00
+	blobCodecIdZstd               byte = 0x01
+	blobCodecHeaderLen                 = 2
+)
+
+// Kinds of stored data, used as metric labels.
+const (
+	blobKindMiniblock = "miniblock"
+	blobKindCandidate = "candidate"
+	blobKindEnvelope  = "envelope"
+)
+
+// blobCodec compresses miniblocks and envelopes before they are written to the database
+// and decompresses them on read.
+//
+// Compressed data is prefixed with two byte header: blobCodecMarker followed by the codec id.
+// Serialized protobuf messages never start with zero byte since zero is not a valid field number,
+// so data written before compression was enabled doesn't need the header and is returned as is.
+// Data that doesn't compress is stored with the raw codec id by recompression,
+// so it is not picked up by the following passes again.
+// zstd frames record id of the dictionary they were compressed with, so dictionaries are
+// looked up by the decoder and don't need to be recorded in the header.
+type blobCodec struct {
+	// encoders are keyed by stream type, defaultEncoder is used for types without a dictionary.
+	// defaultEncoder is nil if compression of new data is disabled.
+	encoders       map[byte]*zstd.Encoder
+	defaultEncoder *zstd.Encoder
+	decoder        *zstd.Decoder
+	minSize        int
+
+	inputBytes  *prometheus.CounterVec
+	outputBytes *prometheus.CounterVec
+	ratio       *prometheus.HistogramVec
+}
+
+func newBlobCodec(cfg *config.CompressionConfig, metrics infra.MetricsFactory) (*blobCodec, error) {
+	c := &blobCodec{
+		encoders: make(map[byte]*zstd.Encoder),
+		minSize:  cfg.MinSize,
+	}
+	if c.minSize <= 0 {
+		c.minSize = defaultCompressionMinSize
+	}
+
+	var dicts [][]byte
+	dictsByType := make(map[byte][]byte)
+	for prefix, path := range cfg.Dictionaries {
+		streamType, err := hex.DecodeString(prefix)
+		if err != nil || len(streamType) != 1 {
+			return nil, RiverError(Err_BAD_CONFIG, "Bad stream type prefix for compression dictionary",
+				"prefix", prefix).Func("newBlobCodec")
+		}
+		dict, err := os.ReadFile(path)
+		if err != nil {
+			return nil, AsRiverError(err, Err_BAD_CONFIG).
+				Message("Failed to read compression dictionary").
+				Tag("path", path).
+				Func("newBlobCodec")
+		}
+		dicts = append(dicts, dict)
+		dictsByType[streamType[0]] = dict
+	}
+
+	var err error
+	c.decoder, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderDicts(dicts...))
+	if err != nil {
+		return nil, AsRiverError(err, Err_BAD_CONFIG).Message("Failed to create zstd decoder").Func("newBlobCodec")
+	}
+
+	switch strings.ToLower(cfg.Codec) {
+	case "", BlobCodecNone:
+	case BlobCodecZstd:
+		level := zstd.SpeedDefault
+		if cfg.Level > 0 {
+			level = zstd.EncoderLevelFromZstd(cfg.Level)
+		}
+		c.defaultEncoder, err = zstd.NewWriter(nil, zstd.WithEncoderLevel(level), zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, AsRiverError(err, Err_BAD_CONFIG).Message("Failed to create zstd encoder").Func("newBlobCodec")
+		}
+		for streamType, dict := range dictsByType {
+			c.encoders[streamType], err = zstd.NewWriter(
+				nil,
+				zstd.WithEncoderLevel(level),
+				zstd.WithEncoderConcurrency(1),
+				zstd.WithEncoderDict(dict),
+			)
+			if err != nil {
+				return nil, AsRiverError(err, Err_BAD_CONFIG).
+					Message("Failed to create zstd encoder with dictionary").
+					Tag("streamType", streamType).
+					Func("newBlobCodec")
+			}
+		}
+	default:
+		return nil, RiverError(Err_BAD_CONFIG, "Unknown compression codec", "codec", cfg.Codec).Func("newBlobCodec")
+	}
+
+	c.inputBytes = metrics.NewCounterVecEx(
+		"storage_compression_input_bytes",
+		"Size of data passed to compression",
+		"kind",
+	)
+	c.outputBytes = metrics.NewCounterVecEx(
+		"storage_compression_output_bytes",
+		"Size of data written to storage after compression",
+		"kind",
+	)
+	c.ratio = metrics.NewHistogramVecEx(
+		"storage_compression_ratio",
+		"Ratio of compressed to uncompressed data size",
+		[]float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1},
+		"kind",
+	)
+
+	return c, nil
+}
+
+// enabled returns true if new data is compressed.
+func (c *blobCodec) enabled() bool {
+	return c.defaultEncoder != nil
+}
+
+// encode returns data in the form it should be stored in the database.
+// Data is stored as is if compression is disabled, data is too small or doesn't compress.
+func (c *blobCodec) encode(kind string, streamId StreamId, data []byte) []byte {
+	if c.defaultEncoder == nil || len(data) < c.minSize {
+		return data
+	}
+
+	encoder, ok := c.encoders[streamId.Type()]
+	if !ok {
+		encoder = c.defaultEncoder
+	}
+
+	out := make([]byte, blobCodecHeaderLen, blobCodecHeaderLen+len(data)/2)
+	out[0] = blobCodecMarker
+	out[1] = blobCodecIdZstd
+	out = encoder.EncodeAll(data, out)
+
+	c.inputBytes.WithLabelValues(kind).Add(float64(len(data)))
+	if len(out) >= len(data) {
+		c.outputBytes.WithLabelValues(kind).Add(float64(len(data)))
+		c.ratio.WithLabelValues(kind).Observe(1)
+		return data
+	}
+	c.outputBytes.WithLabelValues(kind).Add(float64(len(out)))
+	c.ratio.WithLabelValues(kind).Observe(float64(len(out)) / float64(len(data)))
+	return out
+}
+
+// decode returns data as it was passed to encode.
+func (c *blobCodec) decode(data []byte) ([]byte, error) {
+	if !isEncodedBlob(data) {
+		return data, nil
+	}
+	if len(data) < blobCodecHeaderLen {
+		return nil, RiverError(Err_INTERNAL, "Stored data has truncated codec header").Func("blobCodec.decode")
+	}
+
+	switch data[1] {
+	case blobCodecIdRaw:
+		return data[blobCodecHeaderLen:], nil
+	case blobCodecIdZstd:
+		out, err := c.decoder.DecodeAll(data[blobCodecHeaderLen:], nil)
+		if err != nil {
+			return nil, AsRiverError(err, Err_INTERNAL).Message("Failed to decompress stored data").
+				Func("blobCodec.decode")
+		}
+		return out, nil
+	default:
+		return nil, RiverError(Err_INTERNAL, "Unknown codec of stored data", "codecId", data[1]).
+			Func("blobCodec.decode")
+	}
+}
+
+// decodeAll decodes each element of the given slice in place.
+func (c *blobCodec) decodeAll(data [][]byte) error {
+	for i, d := range data {
+		decoded, err := c.decode(d)
+		if err != nil {
+			return err
+		}
+		data[i] = decoded
+	}
+	return nil
+}
+
+// rawBlob returns data with the raw codec header.
+func rawBlob(data []byte) []byte {
+	out := make([]byte, blobCodecHeaderLen, blobCodecHeaderLen+len(data))
+	out[0] = blobCodecMarker
+	out[1] = blobCodecIdRaw
+	return append(out, data...)
+}
+
+// isEncodedBlob returns true if data has codec header, i.e. it was compressed before it was stored.
+func isEncodedBlob(data []byte) bool {
+	return len(data) > 0 && data[0] == blobCodecMarker
+}
+
+func (c *blobCodec) close() {
+	c.decoder.Close()
+	if c.defaultEncoder != nil {
+		_ = c.defaultEncoder.Close()
+	}
+	for _, encoder := range c.encoders {
+		_ = encoder.Close()
+	}
+}
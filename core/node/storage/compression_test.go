@@ -0,0 +1,144 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/dict"
+	"github.com/stretchr/testify/require"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func newTestBlobCodec(t *testing.T, cfg *config.CompressionConfig) *blobCodec {
+	codec, err := newBlobCodec(cfg, infra.NewMetricsFactory(nil, "", ""))
+	require.NoError(t, err)
+	t.Cleanup(codec.close)
+	return codec
+}
+
+func TestBlobCodec(t *testing.T) {
+	require := require.New(t)
+
+	plain := newTestBlobCodec(t, &config.CompressionConfig{})
+	zstdCodec := newTestBlobCodec(t, &config.CompressionConfig{Codec: BlobCodecZstd})
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	data := bytes.Repeat([]byte("compressible miniblock data "), 100)
+
+	// Compression disabled: data is stored as is.
+	require.False(plain.enabled())
+	require.Equal(data, plain.encode(blobKindMiniblock, streamId, data))
+
+	encoded := zstdCodec.encode(blobKindMiniblock, streamId, data)
+	require.True(isEncodedBlob(encoded))
+	require.Less(len(encoded), len(data))
+
+	// Both codecs read both compressed and uncompressed data.
+	for _, codec := range []*blobCodec{plain, zstdCodec} {
+		decoded, err := codec.decode(encoded)
+		require.NoError(err)
+		require.Equal(data, decoded)
+
+		decoded, err = codec.decode(data)
+		require.NoError(err)
+		require.Equal(data, decoded)
+
+		decoded, err = codec.decode(nil)
+		require.NoError(err)
+		require.Nil(decoded)
+	}
+
+	// Small and incompressible data is stored as is.
+	small := []byte("small")
+	require.Equal(small, zstdCodec.encode(blobKindEnvelope, streamId, small))
+	random := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.Equal(random[:], zstdCodec.encode(blobKindEnvelope, streamId, random[:]))
+
+	// Raw blobs are decoded by both codecs.
+	for _, codec := range []*blobCodec{plain, zstdCodec} {
+		decoded, err := codec.decode(rawBlob(random[:]))
+		require.NoError(err)
+		require.Equal(random[:], decoded)
+	}
+
+	_, err := zstdCodec.decode([]byte{blobCodecMarker})
+	require.Equal(Err_INTERNAL, AsRiverError(err).Code)
+	_, err = zstdCodec.decode([]byte{blobCodecMarker, 0x7f, 1, 2, 3})
+	require.ErrorContains(err, "Unknown codec of stored data")
+	_, err = zstdCodec.decode([]byte{blobCodecMarker, blobCodecIdZstd, 1, 2, 3})
+	require.ErrorContains(err, "Failed to decompress stored data")
+}
+
+func TestBlobCodecConfig(t *testing.T) {
+	require := require.New(t)
+	metrics := infra.NewMetricsFactory(nil, "", "")
+
+	_, err := newBlobCodec(&config.CompressionConfig{Codec: "lz4"}, metrics)
+	require.Equal(Err_BAD_CONFIG, AsRiverError(err).Code)
+
+	_, err = newBlobCodec(&config.CompressionConfig{Dictionaries: map[string]string{"channel": "dict"}}, metrics)
+	require.Equal(Err_BAD_CONFIG, AsRiverError(err).Code)
+
+	_, err = newBlobCodec(
+		&config.CompressionConfig{Dictionaries: map[string]string{"20": filepath.Join(t.TempDir(), "missing")}},
+		metrics,
+	)
+	require.Equal(Err_BAD_CONFIG, AsRiverError(err).Code)
+
+	// Dictionary must be in zstd dictionary format.
+	dictPath := filepath.Join(t.TempDir(), "dict")
+	require.NoError(os.WriteFile(dictPath, []byte("not a dictionary"), 0o600))
+	_, err = newBlobCodec(
+		&config.CompressionConfig{Codec: BlobCodecZstd, Dictionaries: map[string]string{"20": dictPath}},
+		metrics,
+	)
+	require.Equal(Err_BAD_CONFIG, AsRiverError(err).Code)
+}
+
+func TestBlobCodecDictionary(t *testing.T) {
+	require := require.New(t)
+
+	var samples [][]byte
+	for i := range 200 {
+		samples = append(samples, []byte(fmt.Sprintf("channel message %d with a common channel message prefix", i)))
+	}
+	dictionary, err := dict.BuildZstdDict(samples, dict.Options{MaxDictSize: 4096, HashBytes: 6, ZstdDictID: 1})
+	require.NoError(err)
+	dictPath := filepath.Join(t.TempDir(), "channel.dict")
+	require.NoError(os.WriteFile(dictPath, dictionary, 0o600))
+
+	cfg := &config.CompressionConfig{
+		Codec:        BlobCodecZstd,
+		MinSize:      1,
+		Dictionaries: map[string]string{"20": dictPath},
+	}
+	withDict := newTestBlobCodec(t, cfg)
+	noDict := newTestBlobCodec(t, &config.CompressionConfig{Codec: BlobCodecZstd, MinSize: 1})
+
+	channelId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	data := []byte("channel message 1000 with a common channel message prefix")
+	encoded := withDict.encode(blobKindEnvelope, channelId, data)
+	require.True(isEncodedBlob(encoded))
+	require.Less(len(encoded), len(noDict.encode(blobKindEnvelope, channelId, data)))
+
+	decoded, err := withDict.decode(encoded)
+	require.NoError(err)
+	require.Equal(data, decoded)
+
+	// Data compressed with dictionary can't be read without it.
+	_, err = noDict.decode(encoded)
+	require.Error(err)
+
+	// Other stream types are compressed without dictionary.
+	userId := testutils.FakeStreamId(STREAM_USER_BIN)
+	require.Equal(noDict.encode(blobKindEnvelope, userId, data), withDict.encode(blobKindEnvelope, userId, data))
+}
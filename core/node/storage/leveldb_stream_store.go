@@ -0,0 +1,1308 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// LevelDbStreamStore is an embedded StreamStorage implementation that keeps all stream data
+// in a single LevelDB data directory.
+//
+// Key layout, all numbers are big-endian so keys sort in the numeric order:
+//
+//	s<streamId>                            -> latest snapshot miniblock number, first retained miniblock number
+//	b<streamId><seqNum>                    -> miniblock
+//	n<streamId><seqNum>                    -> empty, present for retained snapshot miniblocks
+//	p<streamId><generation><slotNum + 1>   -> minipool envelope, slotNum -1 is the generation marker
+//	c<streamId><seqNum><blockHash>         -> miniblock candidate
+//	t<streamId>                            -> tombstone: deleted at (unix nanoseconds), requester, reason
+//
+// Since LevelDB has no transactions, all writes are serialized with mu and applied in a single batch,
+// while reads are allowed to run concurrently with each other.
+type LevelDbStreamStore struct {
+	db      *leveldb.DB
+	dataDir string
+
+	mu sync.RWMutex
+
+	opCounter  *infra.StatusCounterVec
+	opDuration *prometheus.HistogramVec
+}
+
+var _ StreamStorage = (*LevelDbStreamStore)(nil)
+
+const (
+	ldbStreamPrefix    = 's'
+	ldbMiniblockPrefix = 'b'
+	ldbSnapshotPrefix  = 'n'
+	ldbMinipoolPrefix  = 'p'
+	ldbCandidatePrefix = 'c'
+	ldbTombstonePrefix = 't'
+)
+
+func NewLevelDbStreamStore(
+	ctx context.Context,
+	cfg *config.EmbeddedStorageConfig,
+	metrics infra.MetricsFactory,
+) (*LevelDbStreamStore, error) {
+	if cfg.DataDir == "" {
+		return nil, RiverError(Err_BAD_CONFIG, "Data directory is not set for embedded storage").
+			Func("NewLevelDbStreamStore")
+	}
+
+	if err := os.MkdirAll(cfg.DataDir, 0o755); err != nil {
+		return nil, WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("Failed to create data directory").
+			Tag("dataDir", cfg.DataDir).
+			Func("NewLevelDbStreamStore")
+	}
+
+	// OpenFile takes an exclusive lock on the data directory,
+	// so a second node instance using the same directory fails here.
+	db, err := leveldb.OpenFile(cfg.DataDir, nil)
+	if err != nil {
+		return nil, WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("Failed to open embedded storage").
+			Tag("dataDir", cfg.DataDir).
+			Func("NewLevelDbStreamStore")
+	}
+
+	dlog.FromCtx(ctx).Info("Opened embedded stream storage", "dataDir", cfg.DataDir)
+
+	return &LevelDbStreamStore{
+		db:        db,
+		dataDir:   cfg.DataDir,
+		opCounter: metrics.NewStatusCounterVecEx("leveldb_op_status", "LevelDB operation status", "name"),
+		opDuration: metrics.NewHistogramVecEx(
+			"leveldb_op_duration_seconds",
+			"LevelDB operation duration",
+			infra.DefaultDurationBucketsSeconds,
+			"name",
+		),
+	}, nil
+}
+
+// opRunner runs storage operation under the store lock, reports metrics and wraps errors
+// the same way PostgresEventStore.txRunner does.
+func (s *LevelDbStreamStore) opRunner(
+	ctx context.Context,
+	name string,
+	write bool,
+	opFn func() error,
+	opts *txRunnerOpts,
+	tags ...any,
+) error {
+	defer prometheus.NewTimer(s.opDuration.WithLabelValues(name)).ObserveDuration()
+
+	var err error
+	if write {
+		s.mu.Lock()
+		err = opFn()
+		s.mu.Unlock()
+	} else {
+		err = ctx.Err()
+		if err == nil {
+			s.mu.RLock()
+			err = opFn()
+			s.mu.RUnlock()
+		}
+	}
+
+	if err != nil {
+		level := slog.LevelWarn
+		if opts != nil && opts.skipLoggingNotFound && AsRiverError(err).Code == Err_NOT_FOUND {
+			level = slog.LevelDebug
+			s.opCounter.IncPass(name)
+		} else {
+			s.opCounter.IncFail(name)
+		}
+		dlog.FromCtx(ctx).Log(ctx, level, "leveldb.opRunner: operation failed", append(tags, "name", name, "err", err)...)
+
+		return WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Func("leveldb.opRunner").
+			Message("operation failed").
+			Tag("name", name).
+			Tags(tags...)
+	}
+
+	s.opCounter.IncPass(name)
+	return nil
+}
+
+func ldbStreamPrefixKey(prefix byte, streamId StreamId, extraLen int) []byte {
+	key := make([]byte, 1, 1+STREAM_ID_BYTES_LENGTH+extraLen)
+	key[0] = prefix
+	return append(key, streamId[:]...)
+}
+
+func ldbStreamKey(streamId StreamId) []byte {
+	return ldbStreamPrefixKey(ldbStreamPrefix, streamId, 0)
+}
+
+func ldbMiniblockKey(streamId StreamId, seqNum int64) []byte {
+	return binary.BigEndian.AppendUint64(ldbStreamPrefixKey(ldbMiniblockPrefix, streamId, 8), uint64(seqNum))
+}
+
+func ldbSnapshotKey(streamId StreamId, seqNum int64) []byte {
+	return binary.BigEndian.AppendUint64(ldbStreamPrefixKey(ldbSnapshotPrefix, streamId, 8), uint64(seqNum))
+}
+
+func ldbMinipoolKey(streamId StreamId, generation int64, slotNum int64) []byte {
+	key := ldbStreamPrefixKey(ldbMinipoolPrefix, streamId, 16)
+	key = binary.BigEndian.AppendUint64(key, uint64(generation))
+	return binary.BigEndian.AppendUint64(key, uint64(slotNum+1))
+}
+
+func ldbParseMinipoolKey(key []byte) (generation int64, slotNum int64) {
+	offset := 1 + STREAM_ID_BYTES_LENGTH
+	generation = int64(binary.BigEndian.Uint64(key[offset:]))
+	slotNum = int64(binary.BigEndian.Uint64(key[offset+8:])) - 1
+	return
+}
+
+func ldbCandidateKey(streamId StreamId, seqNum int64, blockHash common.Hash) []byte {
+	key := ldbStreamPrefixKey(ldbCandidatePrefix, streamId, 8+common.HashLength)
+	key = binary.BigEndian.AppendUint64(key, uint64(seqNum))
+	return append(key, blockHash[:]...)
+}
+
+func ldbParseSeqNumKey(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key[1+STREAM_ID_BYTES_LENGTH:]))
+}
+
+func (s *LevelDbStreamStore) iterStream(prefix byte, streamId StreamId) iterator.Iterator {
+	return s.db.NewIterator(util.BytesPrefix(ldbStreamPrefixKey(prefix, streamId, 0)), nil)
+}
+
+// ldbStreamInfo is the value of the stream record.
+type ldbStreamInfo struct {
+	lastSnapshotMiniblock int64
+	// firstMiniblock is the number of the first miniblock retained after pruning.
+	firstMiniblock int64
+}
+
+// readStreamRecord returns stream record or NOT_FOUND error if stream doesn't exist.
+func (s *LevelDbStreamStore) readStreamRecord(streamId StreamId) (ldbStreamInfo, error) {
+	value, err := s.db.Get(ldbStreamKey(streamId), nil)
+	if err != nil {
+		if err == leveldb.ErrNotFound {
+			return ldbStreamInfo{}, RiverError(Err_NOT_FOUND, "Stream not found", "streamId", streamId)
+		}
+		return ldbStreamInfo{}, err
+	}
+	info := ldbStreamInfo{lastSnapshotMiniblock: int64(binary.BigEndian.Uint64(value))}
+	// Records written before pruning was supported contain only the latest snapshot number.
+	if len(value) >= 16 {
+		info.firstMiniblock = int64(binary.BigEndian.Uint64(value[8:]))
+	}
+	return info, nil
+}
+
+func ldbStreamRecord(info ldbStreamInfo) []byte {
+	value := binary.BigEndian.AppendUint64(nil, uint64(info.lastSnapshotMiniblock))
+	return binary.BigEndian.AppendUint64(value, uint64(info.firstMiniblock))
+}
+
+// lastMiniblockNum returns the number of the last miniblock in storage, or -1 if there are no miniblocks.
+func (s *LevelDbStreamStore) lastMiniblockNum(streamId StreamId) (int64, error) {
+	iter := s.iterStream(ldbMiniblockPrefix, streamId)
+	defer iter.Release()
+	if iter.Last() {
+		return ldbParseSeqNumKey(iter.Key()), nil
+	}
+	return -1, iter.Error()
+}
+
+func (s *LevelDbStreamStore) streamExists(streamId StreamId) (bool, error) {
+	return s.db.Has(ldbStreamKey(streamId), nil)
+}
+
+func (s *LevelDbStreamStore) CreateStreamStorage(
+	ctx context.Context,
+	streamId StreamId,
+	genesisMiniblock []byte,
+) error {
+	return s.opRunner(
+		ctx,
+		"CreateStreamStorage",
+		true,
+		func() error {
+			exists, err := s.streamExists(streamId)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return RiverError(Err_ALREADY_EXISTS, "stream already exists")
+			}
+
+			batch := new(leveldb.Batch)
+			batch.Put(ldbStreamKey(streamId), ldbStreamRecord(ldbStreamInfo{}))
+			batch.Put(ldbMiniblockKey(streamId, 0), genesisMiniblock)
+			batch.Put(ldbSnapshotKey(streamId, 0), nil)
+			batch.Put(ldbMinipoolKey(streamId, 1, -1), nil)
+			return s.db.Write(batch, nil)
+		},
+		nil,
+		"streamId", streamId,
+	)
+}
+
+func (s *LevelDbStreamStore) CreateStreamArchiveStorage(
+	ctx context.Context,
+	streamId StreamId,
+) error {
+	return s.opRunner(
+		ctx,
+		"CreateStreamArchiveStorage",
+		true,
+		func() error {
+			exists, err := s.streamExists(streamId)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return RiverError(Err_ALREADY_EXISTS, "stream already exists")
+			}
+			return s.db.Put(ldbStreamKey(streamId), ldbStreamRecord(ldbStreamInfo{lastSnapshotMiniblock: -1}), nil)
+		},
+		nil,
+		"streamId", streamId,
+	)
+}
+
+func (s *LevelDbStreamStore) GetMaxArchivedMiniblockNumber(
+	ctx context.Context,
+	streamId StreamId,
+) (int64, error) {
+	var maxArchivedMiniblockNumber int64
+	err := s.opRunner(
+		ctx,
+		"GetMaxArchivedMiniblockNumber",
+		false,
+		func() error {
+			var err error
+			maxArchivedMiniblockNumber, err = s.getMaxArchivedMiniblockNumberNoLock(streamId)
+			return err
+		},
+		&txRunnerOpts{skipLoggingNotFound: true},
+		"streamId", streamId,
+	)
+	if err != nil {
+		return -1, err
+	}
+	return maxArchivedMiniblockNumber, nil
+}
+
+func (s *LevelDbStreamStore) getMaxArchivedMiniblockNumberNoLock(streamId StreamId) (int64, error) {
+	exists, err := s.streamExists(streamId)
+	if err != nil {
+		return -1, err
+	}
+	if !exists {
+		return -1, RiverError(Err_NOT_FOUND, "stream not found in local storage", "streamId", streamId)
+	}
+	return s.lastMiniblockNum(streamId)
+}
+
+func (s *LevelDbStreamStore) WriteArchiveMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	startMiniblockNum int64,
+	miniblocks [][]byte,
+) error {
+	return s.opRunner(
+		ctx,
+		"WriteArchiveMiniblocks",
+		true,
+		func() error {
+			lastKnownMiniblockNum, err := s.getMaxArchivedMiniblockNumberNoLock(streamId)
+			if err != nil {
+				return err
+			}
+			if lastKnownMiniblockNum+1 != startMiniblockNum {
+				return RiverError(
+					Err_DB_OPERATION_FAILURE,
+					"miniblock sequence number mismatch",
+					"lastKnownMiniblockNum", lastKnownMiniblockNum,
+					"startMiniblockNum", startMiniblockNum,
+					"streamId", streamId,
+				)
+			}
+
+			batch := new(leveldb.Batch)
+			for i, miniblock := range miniblocks {
+				batch.Put(ldbMiniblockKey(streamId, startMiniblockNum+int64(i)), miniblock)
+			}
+			return s.db.Write(batch, nil)
+		},
+		nil,
+		"streamId", streamId,
+		"startMiniblockNum", startMiniblockNum,
+		"numMiniblocks", len(miniblocks),
+	)
+}
+
+func (s *LevelDbStreamStore) ReadStreamFromLastSnapshot(
+	ctx context.Context,
+	streamId StreamId,
+	numToRead int,
+) (*ReadStreamFromLastSnapshotResult, error) {
+	var ret *ReadStreamFromLastSnapshotResult
+	err := s.opRunner(
+		ctx,
+		"ReadStreamFromLastSnapshot",
+		false,
+		func() error {
+			var err error
+			ret, err = s.readStreamFromLastSnapshotNoLock(streamId, numToRead)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *LevelDbStreamStore) ReadStreamsFromLastSnapshot(
+	ctx context.Context,
+	streamIds []StreamId,
+	numToRead int,
+) (map[StreamId]*ReadStreamFromLastSnapshotResult, error) {
+	ret := make(map[StreamId]*ReadStreamFromLastSnapshotResult, len(streamIds))
+	err := s.opRunner(
+		ctx,
+		"ReadStreamsFromLastSnapshot",
+		false,
+		func() error {
+			for _, streamId := range streamIds {
+				result, err := s.readStreamFromLastSnapshotNoLock(streamId, numToRead)
+				if err != nil {
+					if AsRiverError(err).Code == Err_NOT_FOUND {
+						continue
+					}
+					return AsRiverError(err).Tag("streamId", streamId)
+				}
+				ret[streamId] = result
+			}
+			return nil
+		},
+		nil,
+		"numStreams", len(streamIds),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *LevelDbStreamStore) readStreamFromLastSnapshotNoLock(
+	streamId StreamId,
+	numToRead int,
+) (*ReadStreamFromLastSnapshotResult, error) {
+	info, err := s.readStreamRecord(streamId)
+	if err != nil {
+		return nil, err
+	}
+	snapshotMiniblockIndex := info.lastSnapshotMiniblock
+
+	lastMiniblockIndex, err := s.lastMiniblockNum(streamId)
+	if err != nil {
+		return nil, err
+	}
+	if lastMiniblockIndex < 0 {
+		return nil, RiverError(Err_INTERNAL, "db inconsistency: failed to get last miniblock index")
+	}
+
+	numToRead = max(1, numToRead)
+	startSeqNum := max(0, lastMiniblockIndex-int64(numToRead-1))
+	startSeqNum = max(min(startSeqNum, snapshotMiniblockIndex), info.firstMiniblock)
+
+	iter := s.db.NewIterator(
+		&util.Range{
+			Start: ldbMiniblockKey(streamId, startSeqNum),
+			Limit: ldbMiniblockKey(streamId, lastMiniblockIndex+1),
+		},
+		nil,
+	)
+	defer iter.Release()
+
+	var miniblocks [][]byte
+	var counter int64 = 0
+	var readLastSeqNum int64
+	var readFirstSeqNum int64
+	for iter.Next() {
+		readLastSeqNum = ldbParseSeqNumKey(iter.Key())
+		if counter == 0 {
+			readFirstSeqNum = readLastSeqNum
+		} else if readLastSeqNum != readFirstSeqNum+counter {
+			return nil, RiverError(
+				Err_INTERNAL,
+				"Miniblocks consistency violation - miniblocks are not sequential in db",
+				"ActualSeqNum", readLastSeqNum,
+				"ExpectedSeqNum", readFirstSeqNum+counter)
+		}
+		miniblocks = append(miniblocks, bytes.Clone(iter.Value()))
+		counter++
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	if !(readFirstSeqNum <= snapshotMiniblockIndex && snapshotMiniblockIndex <= readLastSeqNum) {
+		return nil, RiverError(
+			Err_INTERNAL,
+			"Miniblocks consistency violation - snapshotMiniblocIndex is out of range",
+			"snapshotMiniblockIndex", snapshotMiniblockIndex,
+			"readFirstSeqNum", readFirstSeqNum,
+			"readLastSeqNum", readLastSeqNum)
+	}
+
+	poolIter := s.iterStream(ldbMinipoolPrefix, streamId)
+	defer poolIter.Release()
+
+	var envelopes [][]byte
+	var expectedGeneration int64 = readLastSeqNum + 1
+	var expectedSlot int64 = -1
+	for poolIter.Next() {
+		generation, slotNum := ldbParseMinipoolKey(poolIter.Key())
+		if generation != expectedGeneration {
+			return nil, RiverError(
+				Err_MINIBLOCKS_STORAGE_FAILURE,
+				"Minipool consistency violation - minipool generation doesn't match last miniblock generation",
+			).
+				Tag("generation", generation).
+				Tag("expectedGeneration", expectedGeneration)
+		}
+		if slotNum != expectedSlot {
+			return nil, RiverError(
+				Err_MINIBLOCKS_STORAGE_FAILURE,
+				"Minipool consistency violation - slotNums are not sequential",
+			).
+				Tag("slotNum", slotNum).
+				Tag("expectedSlot", expectedSlot)
+		}
+
+		if slotNum >= 0 {
+			envelopes = append(envelopes, bytes.Clone(poolIter.Value()))
+		}
+		expectedSlot++
+	}
+	if err := poolIter.Error(); err != nil {
+		return nil, err
+	}
+
+	return &ReadStreamFromLastSnapshotResult{
+		StartMiniblockNumber:    readFirstSeqNum,
+		SnapshotMiniblockOffset: int(snapshotMiniblockIndex - readFirstSeqNum),
+		Miniblocks:              miniblocks,
+		MinipoolEnvelopes:       envelopes,
+	}, nil
+}
+
+// WriteEvent adds event to the given minipool.
+// Current generation of minipool should match minipoolGeneration,
+// and there should be exactly minipoolSlot events in the minipool.
+func (s *LevelDbStreamStore) WriteEvent(
+	ctx context.Context,
+	streamId StreamId,
+	minipoolGeneration int64,
+	minipoolSlot int,
+	envelope []byte,
+) error {
+	return s.opRunner(
+		ctx,
+		"WriteEvent",
+		true,
+		func() error {
+			return s.writeEventNoLock(streamId, minipoolGeneration, minipoolSlot, envelope)
+		},
+		nil,
+		"streamId", streamId,
+		"minipoolGeneration", minipoolGeneration,
+		"minipoolSlot", minipoolSlot,
+	)
+}
+
+// Supported consistency checks are the same as in PostgresStreamStore.writeEventTx.
+func (s *LevelDbStreamStore) writeEventNoLock(
+	streamId StreamId,
+	minipoolGeneration int64,
+	minipoolSlot int,
+	envelope []byte,
+) error {
+	if _, err := s.readStreamRecord(streamId); err != nil {
+		return err
+	}
+
+	iter := s.iterStream(ldbMinipoolPrefix, streamId)
+	defer iter.Release()
+
+	var counter int = -1 // counter is set to -1 as we have service record in the first row of minipool
+	for iter.Next() {
+		generation, slotNum := ldbParseMinipoolKey(iter.Key())
+		if generation != minipoolGeneration {
+			return RiverError(Err_DB_OPERATION_FAILURE, "Wrong event generation in minipool").
+				Tag("ExpectedGeneration", minipoolGeneration).Tag("ActualGeneration", generation).
+				Tag("SlotNumber", int(slotNum))
+		}
+		if int(slotNum) != counter {
+			return RiverError(Err_DB_OPERATION_FAILURE, "Wrong slot number in minipool").
+				Tag("ExpectedSlotNumber", counter).Tag("ActualSlotNumber", int(slotNum))
+		}
+		counter++
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	if counter != minipoolSlot {
+		return RiverError(Err_DB_OPERATION_FAILURE, "Wrong number of records in minipool").
+			Tag("ActualRecordsNumber", counter).Tag("ExpectedRecordsNumber", minipoolSlot)
+	}
+
+	return s.db.Put(ldbMinipoolKey(streamId, minipoolGeneration, int64(minipoolSlot)), envelope, nil)
+}
+
+func (s *LevelDbStreamStore) ReadMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([][]byte, error) {
+	var miniblocks [][]byte
+	err := s.opRunner(
+		ctx,
+		"ReadMiniblocks",
+		false,
+		func() error {
+			var err error
+			miniblocks, err = s.readMiniblocksNoLock(streamId, fromInclusive, toExclusive)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return miniblocks, nil
+}
+
+func (s *LevelDbStreamStore) readMiniblocksNoLock(
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([][]byte, error) {
+	info, err := s.readStreamRecord(streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	fromInclusive = max(0, fromInclusive)
+	if fromInclusive < info.firstMiniblock {
+		return nil, miniblocksPrunedError(streamId, fromInclusive, info.firstMiniblock)
+	}
+
+	if toExclusive <= fromInclusive {
+		return nil, nil
+	}
+
+	iter := s.db.NewIterator(
+		&util.Range{
+			Start: ldbMiniblockKey(streamId, fromInclusive),
+			Limit: ldbMiniblockKey(streamId, toExclusive),
+		},
+		nil,
+	)
+	defer iter.Release()
+
+	var miniblocks [][]byte
+	prevSeqNum := -1 // There is no negative generation, so we use it as a flag on the first step of the loop
+	for iter.Next() {
+		seqNum := int(ldbParseSeqNumKey(iter.Key()))
+		if prevSeqNum != -1 && seqNum != prevSeqNum+1 {
+			// There is a gap in sequence numbers
+			return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
+				Tag("ActualBlockNumber", seqNum).Tag("ExpectedBlockNumber", prevSeqNum+1).Tag("streamId", streamId)
+		}
+		prevSeqNum = seqNum
+		miniblocks = append(miniblocks, bytes.Clone(iter.Value()))
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return miniblocks, nil
+}
+
+func (s *LevelDbStreamStore) PruneMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	retainSnapshots int,
+) (int64, error) {
+	var firstMiniblock int64
+	err := s.opRunner(
+		ctx,
+		"PruneMiniblocks",
+		true,
+		func() error {
+			var err error
+			firstMiniblock, err = s.pruneMiniblocksNoLock(streamId, retainSnapshots)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+		"retainSnapshots", retainSnapshots,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return firstMiniblock, nil
+}
+
+func (s *LevelDbStreamStore) pruneMiniblocksNoLock(streamId StreamId, retainSnapshots int) (int64, error) {
+	info, err := s.readStreamRecord(streamId)
+	if err != nil {
+		return 0, err
+	}
+	if retainSnapshots <= 0 {
+		return info.firstMiniblock, nil
+	}
+
+	// Walk snapshot index backwards to find the oldest snapshot to retain.
+	iter := s.iterStream(ldbSnapshotPrefix, streamId)
+	defer iter.Release()
+	boundary := int64(-1)
+	count := 0
+	for ok := iter.Last(); ok && count < retainSnapshots; ok = iter.Prev() {
+		boundary = ldbParseSeqNumKey(iter.Key())
+		count++
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+	if count < retainSnapshots || boundary <= info.firstMiniblock {
+		return info.firstMiniblock, nil
+	}
+
+	batch := new(leveldb.Batch)
+	for _, prefix := range []byte{ldbMiniblockPrefix, ldbSnapshotPrefix} {
+		pruneIter := s.db.NewIterator(
+			&util.Range{
+				Start: ldbStreamPrefixKey(prefix, streamId, 0),
+				Limit: binary.BigEndian.AppendUint64(ldbStreamPrefixKey(prefix, streamId, 8), uint64(boundary)),
+			},
+			nil,
+		)
+		for pruneIter.Next() {
+			batch.Delete(bytes.Clone(pruneIter.Key()))
+		}
+		pruneIter.Release()
+		if err := pruneIter.Error(); err != nil {
+			return 0, err
+		}
+	}
+	info.firstMiniblock = boundary
+	batch.Put(ldbStreamKey(streamId), ldbStreamRecord(info))
+	if err := s.db.Write(batch, nil); err != nil {
+		return 0, err
+	}
+	return boundary, nil
+}
+
+// WriteMiniblockCandidate adds a miniblock proposal candidate. When the miniblock is finalized, the node will promote the
+// candidate with the correct hash.
+func (s *LevelDbStreamStore) WriteMiniblockCandidate(
+	ctx context.Context,
+	streamId StreamId,
+	blockHash common.Hash,
+	blockNumber int64,
+	miniblock []byte,
+) error {
+	return s.opRunner(
+		ctx,
+		"WriteMiniblockCandidate",
+		true,
+		func() error {
+			if _, err := s.readStreamRecord(streamId); err != nil {
+				return err
+			}
+
+			seqNum, err := s.lastMiniblockNum(streamId)
+			if err != nil {
+				return err
+			}
+			if seqNum < 0 {
+				return RiverError(Err_NOT_FOUND, "No blocks for the stream found in block storage")
+			}
+			// Proposal should be for or after the next block number. Candidates from before the next block number are rejected.
+			if blockNumber < seqNum+1 {
+				return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblock proposal blockNumber mismatch").
+					Tag("ExpectedBlockNumber", seqNum+1).Tag("ActualBlockNumber", blockNumber)
+			}
+
+			key := ldbCandidateKey(streamId, blockNumber, blockHash)
+			exists, err := s.db.Has(key, nil)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return RiverError(Err_ALREADY_EXISTS, "Miniblock candidate already exists")
+			}
+			return s.db.Put(key, miniblock, nil)
+		},
+		nil,
+		"streamId", streamId,
+		"blockHash", blockHash,
+		"blockNumber", blockNumber,
+	)
+}
+
+func (s *LevelDbStreamStore) ReadMiniblockCandidate(
+	ctx context.Context,
+	streamId StreamId,
+	blockHash common.Hash,
+	blockNumber int64,
+) ([]byte, error) {
+	var miniblock []byte
+	err := s.opRunner(
+		ctx,
+		"ReadMiniblockCandidate",
+		false,
+		func() error {
+			if _, err := s.readStreamRecord(streamId); err != nil {
+				return err
+			}
+
+			var err error
+			miniblock, err = s.db.Get(ldbCandidateKey(streamId, blockNumber, blockHash), nil)
+			if err == leveldb.ErrNotFound {
+				return RiverError(Err_NOT_FOUND, "Miniblock candidate not found")
+			}
+			return err
+		},
+		nil,
+		"streamId", streamId,
+		"blockHash", blockHash,
+		"blockNumber", blockNumber,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return miniblock, nil
+}
+
+func (s *LevelDbStreamStore) WriteMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolGeneration int64,
+	newMinipoolEnvelopes [][]byte,
+	prevMinipoolGeneration int64,
+	prevMinipoolSize int,
+) error {
+	// Check redundant data in arguments is consistent.
+	if len(miniblocks) == 0 {
+		return RiverError(Err_INTERNAL, "No miniblocks to write").Func("leveldb.WriteMiniblocks")
+	}
+	if prevMinipoolGeneration != miniblocks[0].Number {
+		return RiverError(Err_INTERNAL, "Previous minipool generation mismatch").Func("leveldb.WriteMiniblocks")
+	}
+	if newMinipoolGeneration != miniblocks[len(miniblocks)-1].Number+1 {
+		return RiverError(Err_INTERNAL, "New minipool generation mismatch").Func("leveldb.WriteMiniblocks")
+	}
+	firstMbNum := miniblocks[0].Number
+	for i, mb := range miniblocks {
+		if mb.Number != firstMbNum+int64(i) {
+			return RiverError(Err_INTERNAL, "Miniblock number mismatch").Func("leveldb.WriteMiniblocks")
+		}
+	}
+
+	return s.opRunner(
+		ctx,
+		"WriteMiniblocks",
+		true,
+		func() error {
+			return s.writeMiniblocksNoLock(
+				streamId,
+				miniblocks,
+				newMinipoolGeneration,
+				newMinipoolEnvelopes,
+				prevMinipoolGeneration,
+				prevMinipoolSize,
+			)
+		},
+		nil,
+		"streamId", streamId,
+		"newMinipoolGeneration", newMinipoolGeneration,
+		"newMinipoolSize", len(newMinipoolEnvelopes),
+		"prevMinipoolGeneration", prevMinipoolGeneration,
+		"prevMinipoolSize", prevMinipoolSize,
+		"miniblockSize", len(miniblocks),
+		"firstMiniblockNumber", miniblocks[0].Number,
+		"lastMiniblockNumber", miniblocks[len(miniblocks)-1].Number,
+	)
+}
+
+func (s *LevelDbStreamStore) writeMiniblocksNoLock(
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolGeneration int64,
+	newMinipoolEnvelopes [][]byte,
+	prevMinipoolGeneration int64,
+	prevMinipoolSize int,
+) error {
+	info, err := s.readStreamRecord(streamId)
+	if err != nil {
+		return err
+	}
+
+	lastMbNumInStorage, err := s.lastMiniblockNum(streamId)
+	if err != nil {
+		return err
+	}
+	if lastMbNumInStorage < 0 {
+		return RiverError(
+			Err_INTERNAL,
+			"DB data consistency check failed: No blocks for the stream found in block storage",
+		)
+	}
+	if lastMbNumInStorage+1 != prevMinipoolGeneration {
+		return RiverError(
+			Err_INTERNAL,
+			"DB data consistency check failed: Previous minipool generation mismatch",
+			"lastMbInStorage",
+			lastMbNumInStorage,
+		)
+	}
+
+	batch := new(leveldb.Batch)
+
+	// Delete old minipool and check old data for consistency.
+	iter := s.iterStream(ldbMinipoolPrefix, streamId)
+	defer iter.Release()
+	expectedSlot := int64(-1)
+	for iter.Next() {
+		generation, slot := ldbParseMinipoolKey(iter.Key())
+		if generation != prevMinipoolGeneration {
+			return RiverError(
+				Err_INTERNAL,
+				"DB data consistency check failed: Minipool contains unexpected generation",
+				"generation",
+				generation,
+			)
+		}
+		if slot != expectedSlot {
+			return RiverError(
+				Err_INTERNAL,
+				"DB data consistency check failed: Minipool contains unexpected slot number",
+				"slot_num",
+				slot,
+				"expected_slot_num",
+				expectedSlot,
+			)
+		}
+		batch.Delete(bytes.Clone(iter.Key()))
+		expectedSlot++
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	if prevMinipoolSize != -1 && expectedSlot != int64(prevMinipoolSize) {
+		return RiverError(
+			Err_INTERNAL,
+			"DB data consistency check failed: Previous minipool size mismatch",
+			"actual_size",
+			expectedSlot,
+		)
+	}
+
+	// Insert -1 marker and all new minipool events into minipool.
+	batch.Put(ldbMinipoolKey(streamId, newMinipoolGeneration, -1), nil)
+	for i, envelope := range newMinipoolEnvelopes {
+		batch.Put(ldbMinipoolKey(streamId, newMinipoolGeneration, int64(i)), envelope)
+	}
+
+	// Insert all miniblocks.
+	newLastSnapshotMiniblock := int64(-1)
+	for _, mb := range miniblocks {
+		if mb.Snapshot {
+			newLastSnapshotMiniblock = mb.Number
+			batch.Put(ldbSnapshotKey(streamId, mb.Number), nil)
+		}
+		batch.Put(ldbMiniblockKey(streamId, mb.Number), mb.Data)
+	}
+
+	// Update latest snapshot if needed.
+	if newLastSnapshotMiniblock > -1 {
+		info.lastSnapshotMiniblock = newLastSnapshotMiniblock
+		batch.Put(ldbStreamKey(streamId), ldbStreamRecord(info))
+	}
+
+	// Delete miniblock candidates up to the last miniblock number.
+	candIter := s.db.NewIterator(
+		&util.Range{
+			Start: ldbStreamPrefixKey(ldbCandidatePrefix, streamId, 0),
+			Limit: ldbCandidateKey(streamId, newMinipoolGeneration, common.Hash{}),
+		},
+		nil,
+	)
+	defer candIter.Release()
+	for candIter.Next() {
+		batch.Delete(bytes.Clone(candIter.Key()))
+	}
+	if err := candIter.Error(); err != nil {
+		return err
+	}
+
+	return s.db.Write(batch, nil)
+}
+
+func (s *LevelDbStreamStore) GetStreamsNumber(ctx context.Context) (int, error) {
+	var count int
+	err := s.opRunner(
+		ctx,
+		"GetStreamsNumber",
+		false,
+		func() error {
+			iter := s.db.NewIterator(util.BytesPrefix([]byte{ldbStreamPrefix}), nil)
+			defer iter.Release()
+			for iter.Next() {
+				count++
+			}
+			return iter.Error()
+		},
+		nil,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// GetStreams returns a list of all event streams
+func (s *LevelDbStreamStore) GetStreams(ctx context.Context) ([]StreamId, error) {
+	var streams []StreamId
+	err := s.opRunner(
+		ctx,
+		"GetStreams",
+		false,
+		func() error {
+			iter := s.db.NewIterator(util.BytesPrefix([]byte{ldbStreamPrefix}), nil)
+			defer iter.Release()
+			for iter.Next() {
+				streamId, err := StreamIdFromBytes(iter.Key()[1:])
+				if err != nil {
+					return err
+				}
+				streams = append(streams, streamId)
+			}
+			return iter.Error()
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return streams, nil
+}
+
+func (s *LevelDbStreamStore) GetStreamsStorageUsage(ctx context.Context) ([]*StreamStorageUsage, error) {
+	var ret []*StreamStorageUsage
+	err := s.opRunner(
+		ctx,
+		"GetStreamsStorageUsage",
+		false,
+		func() error {
+			ret = nil
+			usage := make(map[StreamId]*StreamStorageUsage)
+			iter := s.db.NewIterator(util.BytesPrefix([]byte{ldbStreamPrefix}), nil)
+			for iter.Next() {
+				streamId, err := StreamIdFromBytes(iter.Key()[1:])
+				if err != nil {
+					iter.Release()
+					return err
+				}
+				u := &StreamStorageUsage{StreamId: streamId}
+				usage[streamId] = u
+				ret = append(ret, u)
+			}
+			iter.Release()
+			if err := iter.Error(); err != nil {
+				return err
+			}
+
+			for _, prefix := range []byte{ldbMiniblockPrefix, ldbMinipoolPrefix, ldbCandidatePrefix} {
+				iter := s.db.NewIterator(util.BytesPrefix([]byte{prefix}), nil)
+				for iter.Next() {
+					key := iter.Key()
+					if len(key) < 1+STREAM_ID_BYTES_LENGTH {
+						continue
+					}
+					streamId, err := StreamIdFromBytes(key[1 : 1+STREAM_ID_BYTES_LENGTH])
+					if err != nil {
+						iter.Release()
+						return err
+					}
+					u, ok := usage[streamId]
+					if !ok {
+						continue
+					}
+					size := int64(len(iter.Value()))
+					switch prefix {
+					case ldbMiniblockPrefix:
+						u.Miniblocks++
+						u.MiniblocksBytes += size
+					case ldbMinipoolPrefix:
+						// Skip generation marker.
+						if _, slotNum := ldbParseMinipoolKey(key); slotNum >= 0 {
+							u.MinipoolEvents++
+							u.MinipoolBytes += size
+						}
+					case ldbCandidatePrefix:
+						u.Candidates++
+						u.CandidatesBytes += size
+					}
+				}
+				iter.Release()
+				if err := iter.Error(); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *LevelDbStreamStore) DeleteStream(ctx context.Context, streamId StreamId) error {
+	return s.opRunner(
+		ctx,
+		"DeleteStream",
+		true,
+		func() error {
+			if _, err := s.readStreamRecord(streamId); err != nil {
+				return err
+			}
+
+			batch := new(leveldb.Batch)
+			for _, prefix := range []byte{ldbMiniblockPrefix, ldbSnapshotPrefix, ldbMinipoolPrefix, ldbCandidatePrefix} {
+				iter := s.iterStream(prefix, streamId)
+				for iter.Next() {
+					batch.Delete(bytes.Clone(iter.Key()))
+				}
+				iter.Release()
+				if err := iter.Error(); err != nil {
+					return err
+				}
+			}
+			batch.Delete(ldbStreamKey(streamId))
+			return s.db.Write(batch, nil)
+		},
+		nil,
+		"streamId", streamId,
+	)
+}
+
+func ldbTombstoneRecord(tombstone *StreamTombstone) []byte {
+	value := binary.BigEndian.AppendUint64(nil, uint64(tombstone.DeletedAt.UnixNano()))
+	value = append(value, tombstone.Requester[:]...)
+	return append(value, tombstone.Reason...)
+}
+
+func ldbParseTombstone(key []byte, value []byte) (*StreamTombstone, error) {
+	streamId, err := StreamIdFromBytes(key[1:])
+	if err != nil {
+		return nil, err
+	}
+	if len(value) < 8+common.AddressLength {
+		return nil, RiverError(Err_INTERNAL, "Bad tombstone record", "streamId", streamId)
+	}
+	return &StreamTombstone{
+		StreamId:  streamId,
+		DeletedAt: time.Unix(0, int64(binary.BigEndian.Uint64(value))),
+		Requester: common.BytesToAddress(value[8 : 8+common.AddressLength]),
+		Reason:    string(value[8+common.AddressLength:]),
+	}, nil
+}
+
+func (s *LevelDbStreamStore) WriteStreamTombstone(ctx context.Context, tombstone *StreamTombstone) error {
+	return s.opRunner(
+		ctx,
+		"WriteStreamTombstone",
+		true,
+		func() error {
+			key := ldbStreamPrefixKey(ldbTombstonePrefix, tombstone.StreamId, 0)
+			if ok, err := s.db.Has(key, nil); err != nil || ok {
+				return err
+			}
+			return s.db.Put(key, ldbTombstoneRecord(tombstone), nil)
+		},
+		nil,
+		"streamId", tombstone.StreamId,
+	)
+}
+
+func (s *LevelDbStreamStore) ReadStreamTombstones(ctx context.Context) ([]*StreamTombstone, error) {
+	var ret []*StreamTombstone
+	err := s.opRunner(
+		ctx,
+		"ReadStreamTombstones",
+		false,
+		func() error {
+			ret = nil
+			iter := s.db.NewIterator(util.BytesPrefix([]byte{ldbTombstonePrefix}), nil)
+			defer iter.Release()
+			for iter.Next() {
+				tombstone, err := ldbParseTombstone(iter.Key(), iter.Value())
+				if err != nil {
+					return err
+				}
+				ret = append(ret, tombstone)
+			}
+			return iter.Error()
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *LevelDbStreamStore) DebugReadStreamData(
+	ctx context.Context,
+	streamId StreamId,
+) (*DebugReadStreamDataResult, error) {
+	var ret *DebugReadStreamDataResult
+	err := s.opRunner(
+		ctx,
+		"DebugReadStreamData",
+		false,
+		func() error {
+			info, err := s.readStreamRecord(streamId)
+			if err != nil {
+				return err
+			}
+
+			ret = &DebugReadStreamDataResult{
+				StreamId:                   streamId,
+				LatestSnapshotMiniblockNum: info.lastSnapshotMiniblock,
+			}
+
+			iter := s.iterStream(ldbMiniblockPrefix, streamId)
+			for iter.Next() {
+				ret.Miniblocks = append(ret.Miniblocks, MiniblockDescriptor{
+					MiniblockNumber: ldbParseSeqNumKey(iter.Key()),
+					Data:            bytes.Clone(iter.Value()),
+				})
+			}
+			iter.Release()
+			if err := iter.Error(); err != nil {
+				return err
+			}
+
+			iter = s.iterStream(ldbMinipoolPrefix, streamId)
+			for iter.Next() {
+				generation, slot := ldbParseMinipoolKey(iter.Key())
+				ret.Events = append(ret.Events, EventDescriptor{
+					Generation: generation,
+					Slot:       slot,
+					Data:       bytes.Clone(iter.Value()),
+				})
+			}
+			iter.Release()
+			if err := iter.Error(); err != nil {
+				return err
+			}
+
+			iter = s.iterStream(ldbCandidatePrefix, streamId)
+			for iter.Next() {
+				key := iter.Key()
+				ret.MbCandidates = append(ret.MbCandidates, MiniblockDescriptor{
+					MiniblockNumber: ldbParseSeqNumKey(key),
+					Data:            bytes.Clone(iter.Value()),
+					Hash:            common.BytesToHash(key[len(key)-common.HashLength:]),
+				})
+			}
+			iter.Release()
+			return iter.Error()
+		},
+		nil,
+		"streamId", streamId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *LevelDbStreamStore) StreamLastMiniBlock(
+	ctx context.Context,
+	streamID StreamId,
+) (*MiniblockData, error) {
+	var ret *MiniblockData
+	err := s.opRunner(
+		ctx,
+		"StreamLastMiniBlock",
+		false,
+		func() error {
+			if _, err := s.readStreamRecord(streamID); err != nil {
+				return err
+			}
+
+			iter := s.iterStream(ldbMiniblockPrefix, streamID)
+			defer iter.Release()
+			if !iter.Last() {
+				if err := iter.Error(); err != nil {
+					return err
+				}
+				return RiverError(Err_NOT_FOUND, "latest miniblock in DB not found for stream").
+					Tags("stream", streamID).
+					Func("lastMiniBlockForStream")
+			}
+			ret = &MiniblockData{
+				StreamID:      streamID,
+				Number:        ldbParseSeqNumKey(iter.Key()),
+				MiniBlockInfo: bytes.Clone(iter.Value()),
+			}
+			return nil
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Close closes the underlying database and releases the lock on the data directory.
+func (s *LevelDbStreamStore) Close(ctx context.Context) {
+	if err := s.db.Close(); err != nil {
+		dlog.FromCtx(ctx).Error("Error closing embedded storage", "error", err, "dataDir", s.dataDir)
+	}
+}
@@ -0,0 +1,179 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func setupLevelDbStreamStorageTest(t *testing.T) (context.Context, *LevelDbStreamStore, *config.EmbeddedStorageConfig) {
+	ctx, ctxCloser := test.NewTestContext()
+	t.Cleanup(ctxCloser)
+
+	cfg := &config.EmbeddedStorageConfig{DataDir: t.TempDir()}
+	store, err := NewLevelDbStreamStore(ctx, cfg, infra.NewMetricsFactory(nil, "", ""))
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close(ctx) })
+
+	return ctx, store, cfg
+}
+
+func TestLevelDbStreamStoreConformance(t *testing.T) {
+	RunStreamStorageConformanceTests(t, func(ctx context.Context, t *testing.T) StreamStorage {
+		store, err := NewLevelDbStreamStore(
+			ctx,
+			&config.EmbeddedStorageConfig{DataDir: t.TempDir()},
+			infra.NewMetricsFactory(nil, "", ""),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { store.Close(ctx) })
+		return store
+	})
+}
+
+func TestLevelDbStreamStore(t *testing.T) {
+	require := require.New(t)
+	ctx, store, _ := setupLevelDbStreamStorageTest(t)
+
+	streamsNumber, err := store.GetStreamsNumber(ctx)
+	require.NoError(err)
+	require.Equal(0, streamsNumber)
+
+	streamId1 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	streamId2 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	streamId3 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	genesisMiniblock := []byte("genesisMiniblock")
+	require.NoError(store.CreateStreamStorage(ctx, streamId1, genesisMiniblock))
+
+	result, err := store.ReadStreamFromLastSnapshot(ctx, streamId1, 0)
+	require.NoError(err)
+	requireSnapshotResult(t, result, 0, 0, [][]byte{genesisMiniblock}, nil)
+
+	err = store.CreateStreamStorage(ctx, streamId1, []byte("genesisMiniblock2"))
+	require.Equal(Err_ALREADY_EXISTS, AsRiverError(err).Code)
+
+	require.NoError(store.CreateStreamStorage(ctx, streamId2, []byte("genesisMiniblock2")))
+	require.NoError(store.CreateStreamStorage(ctx, streamId3, []byte("genesisMiniblock3")))
+	require.NoError(store.DeleteStream(ctx, streamId2))
+
+	streams, err := store.GetStreams(ctx)
+	require.NoError(err)
+	require.ElementsMatch(streams, []StreamId{streamId1, streamId3})
+
+	require.NoError(store.WriteEvent(ctx, streamId1, 1, 0, []byte("event1")))
+	result, err = store.ReadStreamFromLastSnapshot(ctx, streamId1, 0)
+	require.NoError(err)
+	require.Equal([][]byte{[]byte("event1")}, result.MinipoolEnvelopes)
+
+	blockHash := common.BytesToHash([]byte("block_hash"))
+	require.NoError(store.WriteMiniblockCandidate(ctx, streamId1, blockHash, 1, []byte("block1")))
+	require.NoError(promoteMiniblockCandidate(ctx, store, streamId1, 1, blockHash, true, [][]byte{[]byte("event2")}))
+
+	result, err = store.ReadStreamFromLastSnapshot(ctx, streamId1, 0)
+	require.NoError(err)
+	requireSnapshotResult(t, result, 1, 0, [][]byte{[]byte("block1")}, [][]byte{[]byte("event2")})
+
+	last, err := store.StreamLastMiniBlock(ctx, streamId1)
+	require.NoError(err)
+	require.EqualValues(1, last.Number)
+	require.Equal([]byte("block1"), last.MiniBlockInfo)
+
+	debugData, err := store.DebugReadStreamData(ctx, streamId1)
+	require.NoError(err)
+	require.EqualValues(1, debugData.LatestSnapshotMiniblockNum)
+	require.Len(debugData.Miniblocks, 2)
+	require.Len(debugData.Events, 2)
+	require.Empty(debugData.MbCandidates)
+}
+
+func TestLevelDbAddEventConsistencyChecks(t *testing.T) {
+	require := require.New(t)
+	ctx, store, _ := setupLevelDbStreamStorageTest(t)
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(store.CreateStreamStorage(ctx, streamId, []byte("genesisMiniblock")))
+	require.NoError(store.WriteEvent(ctx, streamId, 1, 0, []byte("event1")))
+	require.NoError(store.WriteEvent(ctx, streamId, 1, 1, []byte("event2")))
+	require.NoError(store.WriteEvent(ctx, streamId, 1, 2, []byte("event3")))
+
+	err := store.WriteEvent(ctx, streamId, 2, 3, []byte("event4"))
+	require.ErrorContains(err, "Wrong event generation in minipool")
+
+	err = store.WriteEvent(ctx, streamId, 1, 2, []byte("event4"))
+	require.ErrorContains(err, "Wrong number of records in minipool")
+
+	// Corrupt minipool by removing a slot in the middle.
+	require.NoError(store.db.Delete(ldbMinipoolKey(streamId, 1, 1), nil))
+	err = store.WriteEvent(ctx, streamId, 1, 3, []byte("event4"))
+	require.ErrorContains(err, "Wrong slot number in minipool")
+	require.Equal(2, AsRiverError(err).GetTag("ActualSlotNumber"))
+	require.Equal(1, AsRiverError(err).GetTag("ExpectedSlotNumber"))
+
+	_, err = store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.Equal(Err_MINIBLOCKS_STORAGE_FAILURE, AsRiverError(err).Code)
+}
+
+func TestLevelDbMiniblocksConsistencyChecks(t *testing.T) {
+	require := require.New(t)
+	ctx, store, _ := setupLevelDbStreamStorageTest(t)
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(store.CreateStreamStorage(ctx, streamId, []byte("genesisMiniblock")))
+	for i := int64(1); i <= 3; i++ {
+		hash := common.BigToHash(common.Big1)
+		require.NoError(store.WriteMiniblockCandidate(ctx, streamId, hash, i, mbDataForNumb(i)))
+		require.NoError(promoteMiniblockCandidate(ctx, store, streamId, i, hash, i == 1, nil))
+	}
+
+	require.NoError(store.db.Delete(ldbMiniblockKey(streamId, 2), nil))
+
+	_, err := store.ReadMiniblocks(ctx, streamId, 1, 4)
+	require.ErrorContains(err, "Miniblocks consistency violation")
+	require.Equal(3, AsRiverError(err).GetTag("ActualBlockNumber"))
+	require.Equal(2, AsRiverError(err).GetTag("ExpectedBlockNumber"))
+
+	_, err = store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.Equal(Err_INTERNAL, AsRiverError(err).Code)
+	require.Equal(int64(3), AsRiverError(err).GetTag("ActualSeqNum"))
+	require.Equal(int64(2), AsRiverError(err).GetTag("ExpectedSeqNum"))
+
+	// Gap before the next candidate makes promotion fail.
+	hash := common.BytesToHash([]byte("hash4"))
+	require.NoError(store.db.Delete(ldbMiniblockKey(streamId, 3), nil))
+	require.NoError(store.WriteMiniblockCandidate(ctx, streamId, hash, 4, mbDataForNumb(4)))
+	err = promoteMiniblockCandidate(ctx, store, streamId, 4, hash, false, nil)
+	require.ErrorContains(err, "DB data consistency check failed: Previous minipool generation mismatch")
+}
+
+func TestLevelDbReopen(t *testing.T) {
+	require := require.New(t)
+	ctx, store, cfg := setupLevelDbStreamStorageTest(t)
+
+	// Data directory is locked while the store is open.
+	_, err := NewLevelDbStreamStore(ctx, cfg, infra.NewMetricsFactory(nil, "", ""))
+	require.Error(err)
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(store.CreateStreamStorage(ctx, streamId, []byte("genesisMiniblock")))
+	require.NoError(store.WriteEvent(ctx, streamId, 1, 0, []byte("event1")))
+	store.Close(ctx)
+
+	store2, err := NewLevelDbStreamStore(ctx, cfg, infra.NewMetricsFactory(nil, "", ""))
+	require.NoError(err)
+	defer store2.Close(ctx)
+
+	result, err := store2.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.NoError(err)
+	requireSnapshotResult(t, result, 0, 0, [][]byte{[]byte("genesisMiniblock")}, [][]byte{[]byte("event1")})
+}
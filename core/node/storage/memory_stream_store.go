@@ -0,0 +1,566 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// MemoryStreamStore is StreamStorage implementation that keeps all data in memory.
+// It returns the same error codes as PostgresStreamStore and is intended for unit tests and ephemeral nodes.
+type MemoryStreamStore struct {
+	mu         sync.RWMutex
+	streams    map[StreamId]*memStream
+	tombstones map[StreamId]*StreamTombstone
+}
+
+var _ StreamStorage = (*MemoryStreamStore)(nil)
+
+type memCandidateKey struct {
+	number int64
+	hash   common.Hash
+}
+
+type memStream struct {
+	lastSnapshotMiniblock int64
+
+	// miniblocks are stored by miniblock number starting from 0.
+	// Entries preceding firstMiniblock are pruned and set to nil.
+	miniblocks     [][]byte
+	firstMiniblock int64
+
+	// snapshots are numbers of retained snapshot miniblocks in ascending order.
+	snapshots []int64
+
+	// hasMinipool is false for archive streams.
+	hasMinipool        bool
+	minipoolGeneration int64
+	minipool           [][]byte
+
+	candidates map[memCandidateKey][]byte
+}
+
+func NewMemoryStreamStore() *MemoryStreamStore {
+	return &MemoryStreamStore{
+		streams:    make(map[StreamId]*memStream),
+		tombstones: make(map[StreamId]*StreamTombstone),
+	}
+}
+
+// withStream runs fn for the given stream under the store lock.
+func (s *MemoryStreamStore) withStream(
+	ctx context.Context,
+	name string,
+	streamId StreamId,
+	write bool,
+	fn func(stream *memStream) error,
+) error {
+	if write {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+	} else {
+		if err := ctx.Err(); err != nil {
+			return AsRiverError(err).Func("mem." + name)
+		}
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+	}
+
+	stream, ok := s.streams[streamId]
+	if !ok {
+		return RiverError(Err_NOT_FOUND, "Stream not found", "streamId", streamId).Func("mem." + name)
+	}
+
+	if err := fn(stream); err != nil {
+		return AsRiverError(err).Func("mem."+name).Tag("streamId", streamId)
+	}
+	return nil
+}
+
+func (s *MemoryStreamStore) createStream(streamId StreamId, stream *memStream) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.streams[streamId]; ok {
+		return RiverError(Err_ALREADY_EXISTS, "stream already exists", "streamId", streamId)
+	}
+	s.streams[streamId] = stream
+	return nil
+}
+
+func (s *MemoryStreamStore) CreateStreamStorage(
+	ctx context.Context,
+	streamId StreamId,
+	genesisMiniblock []byte,
+) error {
+	return s.createStream(streamId, &memStream{
+		miniblocks:         [][]byte{bytes.Clone(genesisMiniblock)},
+		snapshots:          []int64{0},
+		hasMinipool:        true,
+		minipoolGeneration: 1,
+		candidates:         make(map[memCandidateKey][]byte),
+	})
+}
+
+func (s *MemoryStreamStore) CreateStreamArchiveStorage(
+	ctx context.Context,
+	streamId StreamId,
+) error {
+	return s.createStream(streamId, &memStream{
+		lastSnapshotMiniblock: -1,
+		candidates:            make(map[memCandidateKey][]byte),
+	})
+}
+
+func (s *MemoryStreamStore) GetMaxArchivedMiniblockNumber(ctx context.Context, streamId StreamId) (int64, error) {
+	ret := int64(-1)
+	err := s.withStream(ctx, "GetMaxArchivedMiniblockNumber", streamId, false, func(stream *memStream) error {
+		ret = int64(len(stream.miniblocks)) - 1
+		return nil
+	})
+	if err != nil {
+		return -1, err
+	}
+	return ret, nil
+}
+
+func (s *MemoryStreamStore) WriteArchiveMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	startMiniblockNum int64,
+	miniblocks [][]byte,
+) error {
+	return s.withStream(ctx, "WriteArchiveMiniblocks", streamId, true, func(stream *memStream) error {
+		lastKnownMiniblockNum := int64(len(stream.miniblocks)) - 1
+		if lastKnownMiniblockNum+1 != startMiniblockNum {
+			return RiverError(
+				Err_DB_OPERATION_FAILURE,
+				"miniblock sequence number mismatch",
+				"lastKnownMiniblockNum", lastKnownMiniblockNum,
+				"startMiniblockNum", startMiniblockNum,
+			)
+		}
+		for _, mb := range miniblocks {
+			stream.miniblocks = append(stream.miniblocks, bytes.Clone(mb))
+		}
+		return nil
+	})
+}
+
+func cloneAll(data [][]byte) [][]byte {
+	if len(data) == 0 {
+		return nil
+	}
+	ret := make([][]byte, len(data))
+	for i, d := range data {
+		ret[i] = bytes.Clone(d)
+	}
+	return ret
+}
+
+func (s *MemoryStreamStore) ReadStreamFromLastSnapshot(
+	ctx context.Context,
+	streamId StreamId,
+	numToRead int,
+) (*ReadStreamFromLastSnapshotResult, error) {
+	var ret *ReadStreamFromLastSnapshotResult
+	err := s.withStream(ctx, "ReadStreamFromLastSnapshot", streamId, false, func(stream *memStream) error {
+		var err error
+		ret, err = stream.readFromLastSnapshot(numToRead)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *MemoryStreamStore) ReadStreamsFromLastSnapshot(
+	ctx context.Context,
+	streamIds []StreamId,
+	numToRead int,
+) (map[StreamId]*ReadStreamFromLastSnapshotResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, AsRiverError(err).Func("mem.ReadStreamsFromLastSnapshot")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ret := make(map[StreamId]*ReadStreamFromLastSnapshotResult, len(streamIds))
+	for _, streamId := range streamIds {
+		stream, ok := s.streams[streamId]
+		if !ok {
+			continue
+		}
+		result, err := stream.readFromLastSnapshot(numToRead)
+		if err != nil {
+			return nil, AsRiverError(err).Func("mem.ReadStreamsFromLastSnapshot").Tag("streamId", streamId)
+		}
+		ret[streamId] = result
+	}
+	return ret, nil
+}
+
+func (stream *memStream) readFromLastSnapshot(numToRead int) (*ReadStreamFromLastSnapshotResult, error) {
+	lastMiniblockIndex := int64(len(stream.miniblocks)) - 1
+	if lastMiniblockIndex < 0 || stream.lastSnapshotMiniblock < 0 {
+		return nil, RiverError(Err_INTERNAL, "db inconsistency: failed to get last miniblock index")
+	}
+
+	numToRead = max(1, numToRead)
+	startSeqNum := max(0, lastMiniblockIndex-int64(numToRead-1))
+	startSeqNum = max(min(startSeqNum, stream.lastSnapshotMiniblock), stream.firstMiniblock)
+
+	return &ReadStreamFromLastSnapshotResult{
+		StartMiniblockNumber:    startSeqNum,
+		SnapshotMiniblockOffset: int(stream.lastSnapshotMiniblock - startSeqNum),
+		Miniblocks:              cloneAll(stream.miniblocks[startSeqNum:]),
+		MinipoolEnvelopes:       cloneAll(stream.minipool),
+	}, nil
+}
+
+func (s *MemoryStreamStore) ReadMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([][]byte, error) {
+	var ret [][]byte
+	err := s.withStream(ctx, "ReadMiniblocks", streamId, false, func(stream *memStream) error {
+		fromInclusive = max(0, fromInclusive)
+		if fromInclusive < stream.firstMiniblock {
+			return miniblocksPrunedError(streamId, fromInclusive, stream.firstMiniblock)
+		}
+		toExclusive = min(toExclusive, int64(len(stream.miniblocks)))
+		if fromInclusive < toExclusive {
+			ret = cloneAll(stream.miniblocks[fromInclusive:toExclusive])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *MemoryStreamStore) PruneMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	retainSnapshots int,
+) (int64, error) {
+	var ret int64
+	err := s.withStream(ctx, "PruneMiniblocks", streamId, true, func(stream *memStream) error {
+		if retainSnapshots > 0 && len(stream.snapshots) >= retainSnapshots {
+			retained := stream.snapshots[len(stream.snapshots)-retainSnapshots:]
+			for i := stream.firstMiniblock; i < retained[0]; i++ {
+				stream.miniblocks[i] = nil
+			}
+			stream.firstMiniblock = retained[0]
+			stream.snapshots = slices.Clone(retained)
+		}
+		ret = stream.firstMiniblock
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return ret, nil
+}
+
+func (s *MemoryStreamStore) WriteEvent(
+	ctx context.Context,
+	streamId StreamId,
+	minipoolGeneration int64,
+	minipoolSlot int,
+	envelope []byte,
+) error {
+	return s.withStream(ctx, "WriteEvent", streamId, true, func(stream *memStream) error {
+		if stream.hasMinipool && stream.minipoolGeneration != minipoolGeneration {
+			return RiverError(Err_DB_OPERATION_FAILURE, "Wrong event generation in minipool").
+				Tag("ExpectedGeneration", minipoolGeneration).Tag("ActualGeneration", stream.minipoolGeneration)
+		}
+
+		// -1 is for the generation marker that doesn't exist for archive streams.
+		counter := -1
+		if stream.hasMinipool {
+			counter = len(stream.minipool)
+		}
+		if counter != minipoolSlot {
+			return RiverError(Err_DB_OPERATION_FAILURE, "Wrong number of records in minipool").
+				Tag("ActualRecordsNumber", counter).Tag("ExpectedRecordsNumber", minipoolSlot)
+		}
+
+		stream.minipool = append(stream.minipool, bytes.Clone(envelope))
+		return nil
+	})
+}
+
+func (s *MemoryStreamStore) WriteMiniblockCandidate(
+	ctx context.Context,
+	streamId StreamId,
+	blockHash common.Hash,
+	blockNumber int64,
+	miniblock []byte,
+) error {
+	return s.withStream(ctx, "WriteMiniblockCandidate", streamId, true, func(stream *memStream) error {
+		if len(stream.miniblocks) == 0 {
+			return RiverError(Err_NOT_FOUND, "No blocks for the stream found in block storage")
+		}
+		// Proposal should be for or after the next block number.
+		if blockNumber < int64(len(stream.miniblocks)) {
+			return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblock proposal blockNumber mismatch").
+				Tag("ExpectedBlockNumber", int64(len(stream.miniblocks))).Tag("ActualBlockNumber", blockNumber)
+		}
+
+		key := memCandidateKey{number: blockNumber, hash: blockHash}
+		if _, ok := stream.candidates[key]; ok {
+			return RiverError(Err_ALREADY_EXISTS, "Miniblock candidate already exists")
+		}
+		stream.candidates[key] = bytes.Clone(miniblock)
+		return nil
+	})
+}
+
+func (s *MemoryStreamStore) ReadMiniblockCandidate(
+	ctx context.Context,
+	streamId StreamId,
+	blockHash common.Hash,
+	blockNumber int64,
+) ([]byte, error) {
+	var ret []byte
+	err := s.withStream(ctx, "ReadMiniblockCandidate", streamId, false, func(stream *memStream) error {
+		mb, ok := stream.candidates[memCandidateKey{number: blockNumber, hash: blockHash}]
+		if !ok {
+			return RiverError(Err_NOT_FOUND, "Miniblock candidate not found")
+		}
+		ret = bytes.Clone(mb)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *MemoryStreamStore) WriteMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolGeneration int64,
+	newMinipoolEnvelopes [][]byte,
+	prevMinipoolGeneration int64,
+	prevMinipoolSize int,
+) error {
+	// Check redundant data in arguments is consistent.
+	if len(miniblocks) == 0 {
+		return RiverError(Err_INTERNAL, "No miniblocks to write").Func("mem.WriteMiniblocks")
+	}
+	if prevMinipoolGeneration != miniblocks[0].Number {
+		return RiverError(Err_INTERNAL, "Previous minipool generation mismatch").Func("mem.WriteMiniblocks")
+	}
+	if newMinipoolGeneration != miniblocks[len(miniblocks)-1].Number+1 {
+		return RiverError(Err_INTERNAL, "New minipool generation mismatch").Func("mem.WriteMiniblocks")
+	}
+	firstMbNum := miniblocks[0].Number
+	for i, mb := range miniblocks {
+		if mb.Number != firstMbNum+int64(i) {
+			return RiverError(Err_INTERNAL, "Miniblock number mismatch").Func("mem.WriteMiniblocks")
+		}
+	}
+
+	return s.withStream(ctx, "WriteMiniblocks", streamId, true, func(stream *memStream) error {
+		if len(stream.miniblocks) == 0 {
+			return RiverError(
+				Err_INTERNAL,
+				"DB data consistency check failed: No blocks for the stream found in block storage",
+			)
+		}
+		lastMbNumInStorage := int64(len(stream.miniblocks)) - 1
+		if lastMbNumInStorage+1 != prevMinipoolGeneration {
+			return RiverError(
+				Err_INTERNAL,
+				"DB data consistency check failed: Previous minipool generation mismatch",
+				"lastMbInStorage",
+				lastMbNumInStorage,
+			)
+		}
+		if stream.hasMinipool && stream.minipoolGeneration != prevMinipoolGeneration {
+			return RiverError(
+				Err_INTERNAL,
+				"DB data consistency check failed: Minipool contains unexpected generation",
+				"generation",
+				stream.minipoolGeneration,
+			)
+		}
+		if prevMinipoolSize != -1 && len(stream.minipool) != prevMinipoolSize {
+			return RiverError(
+				Err_INTERNAL,
+				"DB data consistency check failed: Previous minipool size mismatch",
+				"actual_size",
+				len(stream.minipool),
+			)
+		}
+
+		for _, mb := range miniblocks {
+			if mb.Snapshot {
+				stream.lastSnapshotMiniblock = mb.Number
+				stream.snapshots = append(stream.snapshots, mb.Number)
+			}
+			stream.miniblocks = append(stream.miniblocks, bytes.Clone(mb.Data))
+		}
+
+		stream.hasMinipool = true
+		stream.minipoolGeneration = newMinipoolGeneration
+		stream.minipool = cloneAll(newMinipoolEnvelopes)
+
+		maps.DeleteFunc(stream.candidates, func(key memCandidateKey, _ []byte) bool {
+			return key.number < newMinipoolGeneration
+		})
+		return nil
+	})
+}
+
+func (s *MemoryStreamStore) GetStreamsNumber(ctx context.Context) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.streams), nil
+}
+
+// GetStreams returns a list of all event streams
+func (s *MemoryStreamStore) GetStreams(ctx context.Context) ([]StreamId, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	streams := make([]StreamId, 0, len(s.streams))
+	for streamId := range s.streams {
+		streams = append(streams, streamId)
+	}
+	return streams, nil
+}
+
+func (s *MemoryStreamStore) GetStreamsStorageUsage(ctx context.Context) ([]*StreamStorageUsage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ret := make([]*StreamStorageUsage, 0, len(s.streams))
+	for streamId, stream := range s.streams {
+		u := &StreamStorageUsage{StreamId: streamId}
+		for _, mb := range stream.miniblocks[stream.firstMiniblock:] {
+			u.Miniblocks++
+			u.MiniblocksBytes += int64(len(mb))
+		}
+		for _, envelope := range stream.minipool {
+			u.MinipoolEvents++
+			u.MinipoolBytes += int64(len(envelope))
+		}
+		for _, candidate := range stream.candidates {
+			u.Candidates++
+			u.CandidatesBytes += int64(len(candidate))
+		}
+		ret = append(ret, u)
+	}
+	return ret, nil
+}
+
+func (s *MemoryStreamStore) DeleteStream(ctx context.Context, streamId StreamId) error {
+	return s.withStream(ctx, "DeleteStream", streamId, true, func(stream *memStream) error {
+		delete(s.streams, streamId)
+		return nil
+	})
+}
+
+func (s *MemoryStreamStore) WriteStreamTombstone(ctx context.Context, tombstone *StreamTombstone) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tombstones[tombstone.StreamId]; !ok {
+		t := *tombstone
+		s.tombstones[tombstone.StreamId] = &t
+	}
+	return nil
+}
+
+func (s *MemoryStreamStore) ReadStreamTombstones(ctx context.Context) ([]*StreamTombstone, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ret := make([]*StreamTombstone, 0, len(s.tombstones))
+	for _, tombstone := range s.tombstones {
+		t := *tombstone
+		ret = append(ret, &t)
+	}
+	return ret, nil
+}
+
+func (s *MemoryStreamStore) DebugReadStreamData(
+	ctx context.Context,
+	streamId StreamId,
+) (*DebugReadStreamDataResult, error) {
+	var ret *DebugReadStreamDataResult
+	err := s.withStream(ctx, "DebugReadStreamData", streamId, false, func(stream *memStream) error {
+		ret = &DebugReadStreamDataResult{
+			StreamId:                   streamId,
+			LatestSnapshotMiniblockNum: stream.lastSnapshotMiniblock,
+		}
+		for i := stream.firstMiniblock; i < int64(len(stream.miniblocks)); i++ {
+			ret.Miniblocks = append(ret.Miniblocks, MiniblockDescriptor{
+				MiniblockNumber: i,
+				Data:            bytes.Clone(stream.miniblocks[i]),
+			})
+		}
+		if stream.hasMinipool {
+			ret.Events = append(ret.Events, EventDescriptor{Generation: stream.minipoolGeneration, Slot: -1})
+			for i, e := range stream.minipool {
+				ret.Events = append(ret.Events, EventDescriptor{
+					Generation: stream.minipoolGeneration,
+					Slot:       int64(i),
+					Data:       bytes.Clone(e),
+				})
+			}
+		}
+		for key, mb := range stream.candidates {
+			ret.MbCandidates = append(ret.MbCandidates, MiniblockDescriptor{
+				MiniblockNumber: key.number,
+				Data:            bytes.Clone(mb),
+				Hash:            key.hash,
+			})
+		}
+		slices.SortFunc(ret.MbCandidates, func(a, b MiniblockDescriptor) int {
+			if a.MiniblockNumber != b.MiniblockNumber {
+				return int(a.MiniblockNumber - b.MiniblockNumber)
+			}
+			return bytes.Compare(a.Hash[:], b.Hash[:])
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *MemoryStreamStore) StreamLastMiniBlock(ctx context.Context, streamID StreamId) (*MiniblockData, error) {
+	var ret *MiniblockData
+	err := s.withStream(ctx, "StreamLastMiniBlock", streamID, false, func(stream *memStream) error {
+		if len(stream.miniblocks) == 0 {
+			return RiverError(Err_NOT_FOUND, "latest miniblock in DB not found for stream")
+		}
+		ret = &MiniblockData{
+			StreamID:      streamID,
+			Number:        int64(len(stream.miniblocks)) - 1,
+			MiniBlockInfo: bytes.Clone(stream.miniblocks[len(stream.miniblocks)-1]),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *MemoryStreamStore) Close(ctx context.Context) {}
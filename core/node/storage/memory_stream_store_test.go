@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestMemoryStreamStoreConformance(t *testing.T) {
+	RunStreamStorageConformanceTests(t, func(ctx context.Context, t *testing.T) StreamStorage {
+		return NewMemoryStreamStore()
+	})
+}
+
+func TestMemoryStreamStoreIsolation(t *testing.T) {
+	require := require.New(t)
+	ctx, ctxCloser := test.NewTestContext()
+	defer ctxCloser()
+
+	store := NewMemoryStreamStore()
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	genesis := []byte("genesisMiniblock")
+	require.NoError(store.CreateStreamStorage(ctx, streamId, genesis))
+	event := []byte("event1")
+	require.NoError(store.WriteEvent(ctx, streamId, 1, 0, event))
+
+	// Mutating buffers passed in or returned must not affect stored data.
+	genesis[0] = 'x'
+	event[0] = 'x'
+	result, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.NoError(err)
+	requireSnapshotResult(t, result, 0, 0, [][]byte{[]byte("genesisMiniblock")}, [][]byte{[]byte("event1")})
+
+	result.Miniblocks[0][0] = 'y'
+	result.MinipoolEnvelopes[0][0] = 'y'
+	result, err = store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.NoError(err)
+	requireSnapshotResult(t, result, 0, 0, [][]byte{[]byte("genesisMiniblock")}, [][]byte{[]byte("event1")})
+
+	streams, err := store.GetStreams(ctx)
+	require.NoError(err)
+	require.Equal([]StreamId{streamId}, streams)
+
+	require.NoError(store.DeleteStream(ctx, streamId))
+	_, err = store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+}
@@ -0,0 +1,9 @@
+-- Index of miniblock ranges offloaded from the miniblocks tables to cold storage.
+CREATE TABLE IF NOT EXISTS offloaded_miniblocks (
+  stream_id CHAR(64) NOT NULL,
+  first_seq_num BIGINT NOT NULL,
+  last_seq_num BIGINT NOT NULL,
+  object_key VARCHAR NOT NULL,
+  PRIMARY KEY (stream_id, first_seq_num)
+);
+ALTER TABLE offloaded_miniblocks ALTER COLUMN stream_id SET STORAGE PLAIN;
@@ -0,0 +1,2 @@
+ALTER TABLE es DROP COLUMN IF EXISTS first_miniblock;
+DROP TABLE IF EXISTS stream_snapshots;
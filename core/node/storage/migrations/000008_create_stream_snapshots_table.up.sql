@@ -0,0 +1,15 @@
+-- Index of snapshot miniblocks, used by the retention policy to find miniblocks to prune.
+CREATE TABLE IF NOT EXISTS stream_snapshots (
+  stream_id CHAR(64) NOT NULL,
+  seq_num BIGINT NOT NULL,
+  PRIMARY KEY (stream_id, seq_num)
+);
+ALTER TABLE stream_snapshots ALTER COLUMN stream_id SET STORAGE PLAIN;
+
+-- Only the latest snapshot is known for existing streams.
+INSERT INTO stream_snapshots (stream_id, seq_num)
+  SELECT stream_id, latest_snapshot_miniblock FROM es WHERE latest_snapshot_miniblock >= 0
+  ON CONFLICT DO NOTHING;
+
+-- Number of the first miniblock retained after pruning.
+ALTER TABLE es ADD COLUMN IF NOT EXISTS first_miniblock BIGINT NOT NULL DEFAULT 0;
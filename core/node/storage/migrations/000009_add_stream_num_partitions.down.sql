@@ -0,0 +1 @@
+ALTER TABLE es DROP COLUMN IF EXISTS num_partitions;
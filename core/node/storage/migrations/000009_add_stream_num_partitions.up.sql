@@ -0,0 +1,7 @@
+-- Partition count of the layout a migrated stream is stored in. Streams are moved to a new
+-- partition layout one by one by repartitioning, so the count is tracked per stream.
+-- Legacy streams with dedicated tables keep 0.
+ALTER TABLE es ADD COLUMN IF NOT EXISTS num_partitions INT NOT NULL DEFAULT 0;
+
+UPDATE es SET num_partitions = (SELECT num_partitions FROM settings WHERE single_row_key = true)
+  WHERE migrated = true;
@@ -0,0 +1,18 @@
+ALTER TABLE miniblock_candidates DROP COLUMN IF EXISTS created_at;
+
+DO $$
+	DECLARE
+
+	tbl TEXT;
+
+	BEGIN
+
+	FOR tbl IN
+		SELECT c.relname FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = current_schema() AND c.relkind = 'r' AND NOT c.relispartition
+			AND c.relname LIKE 'miniblock\_candidates\_%'
+	LOOP
+		EXECUTE 'ALTER TABLE ' || quote_ident(tbl) || ' DROP COLUMN IF EXISTS created_at';
+	END LOOP;
+END;
+$$;
@@ -0,0 +1,23 @@
+-- Track when miniblock candidates were written, so candidates that were never committed
+-- can be removed by the background garbage collection.
+ALTER TABLE miniblock_candidates ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+DO $$
+	DECLARE
+
+	tbl TEXT;
+
+	BEGIN
+
+	-- Fixed partitions are standalone tables, legacy per-stream tables are partitions
+	-- of miniblock_candidates and get the column from it.
+	FOR tbl IN
+		SELECT c.relname FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = current_schema() AND c.relkind = 'r' AND NOT c.relispartition
+			AND c.relname LIKE 'miniblock\_candidates\_%'
+	LOOP
+		EXECUTE 'ALTER TABLE ' || quote_ident(tbl) ||
+			' ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now()';
+	END LOOP;
+END;
+$$;
@@ -0,0 +1,7 @@
+-- Tombstones of deleted streams, kept after stream data is removed so deleted streams are not recreated.
+CREATE TABLE IF NOT EXISTS stream_tombstones (
+  stream_id CHAR(64) NOT NULL PRIMARY KEY,
+  deleted_at TIMESTAMPTZ NOT NULL,
+  requester BYTEA NOT NULL,
+  reason TEXT NOT NULL
+);
@@ -0,0 +1,181 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+const (
+	ObjectStoreTypeFs = "fs"
+	ObjectStoreTypeS3 = "s3"
+)
+
+// ObjectStore stores immutable blobs by key outside of the database.
+type ObjectStore interface {
+	// PutObject stores data under the given key, replacing existing object if any.
+	PutObject(ctx context.Context, key string, data []byte) error
+
+	// GetObject returns data stored under the given key. Err_NOT_FOUND is returned if there is no such object.
+	GetObject(ctx context.Context, key string) ([]byte, error)
+
+	// DeleteObject deletes object with the given key. Deleting missing object is not an error.
+	DeleteObject(ctx context.Context, key string) error
+}
+
+// NewObjectStore creates object store configured for cold storage.
+// nil is returned if cold storage is disabled.
+func NewObjectStore(cfg *config.ColdStorageConfig) (ObjectStore, error) {
+	switch strings.ToLower(cfg.Type) {
+	case "":
+		return nil, nil
+	case ObjectStoreTypeFs:
+		return NewFsObjectStore(cfg.Fs.Path)
+	case ObjectStoreTypeS3:
+		return NewS3ObjectStore(&cfg.S3)
+	default:
+		return nil, RiverError(Err_BAD_CONFIG, "Unknown cold storage type", "type", cfg.Type).Func("NewObjectStore")
+	}
+}
+
+// FsObjectStore is ObjectStore that keeps objects as files in the local directory.
+// Key segments separated by "/" are mapped to subdirectories.
+type FsObjectStore struct {
+	dir string
+}
+
+var _ ObjectStore = (*FsObjectStore)(nil)
+
+func NewFsObjectStore(dir string) (*FsObjectStore, error) {
+	if dir == "" {
+		return nil, RiverError(Err_BAD_CONFIG, "Object store path is not set").Func("NewFsObjectStore")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, AsRiverError(err, Err_BAD_CONFIG).
+			Message("Failed to create object store directory").
+			Tag("path", dir).
+			Func("NewFsObjectStore")
+	}
+	return &FsObjectStore{dir: dir}, nil
+}
+
+func (s *FsObjectStore) path(key string) (string, error) {
+	p := filepath.Join(s.dir, filepath.FromSlash(key))
+	if key == "" || !strings.HasPrefix(p, filepath.Clean(s.dir)+string(filepath.Separator)) {
+		return "", RiverError(Err_INVALID_ARGUMENT, "Invalid object key", "key", key)
+	}
+	return p, nil
+}
+
+func (s *FsObjectStore) PutObject(ctx context.Context, key string, data []byte) error {
+	p, err := s.path(key)
+	if err != nil {
+		return AsRiverError(err).Func("FsObjectStore.PutObject")
+	}
+	if err = os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE).Func("FsObjectStore.PutObject").Tag("key", key)
+	}
+
+	// Write to temporary file and rename, so readers never observe partially written objects.
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".tmp-*")
+	if err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE).Func("FsObjectStore.PutObject").Tag("key", key)
+	}
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), p)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return AsRiverError(err, Err_UNAVAILABLE).Func("FsObjectStore.PutObject").Tag("key", key)
+	}
+	return nil
+}
+
+func (s *FsObjectStore) GetObject(ctx context.Context, key string) ([]byte, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, AsRiverError(err).Func("FsObjectStore.GetObject")
+	}
+	data, err := os.ReadFile(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, RiverError(Err_NOT_FOUND, "Object not found", "key", key).Func("FsObjectStore.GetObject")
+		}
+		return nil, AsRiverError(err, Err_UNAVAILABLE).Func("FsObjectStore.GetObject").Tag("key", key)
+	}
+	return data, nil
+}
+
+func (s *FsObjectStore) DeleteObject(ctx context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return AsRiverError(err).Func("FsObjectStore.DeleteObject")
+	}
+	if err = os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return AsRiverError(err, Err_UNAVAILABLE).Func("FsObjectStore.DeleteObject").Tag("key", key)
+	}
+	return nil
+}
+
+// miniblockRangeMagic starts objects with offloaded miniblock ranges.
+var miniblockRangeMagic = []byte("RVMB\x01")
+
+// encodeMiniblockRange serializes miniblocks as they are stored in the database into a single object.
+func encodeMiniblockRange(miniblocks [][]byte) []byte {
+	size := len(miniblockRangeMagic)
+	for _, mb := range miniblocks {
+		size += 10 + len(mb)
+	}
+	out := make([]byte, 0, size)
+	out = append(out, miniblockRangeMagic...)
+	out = binary.AppendUvarint(out, uint64(len(miniblocks)))
+	for _, mb := range miniblocks {
+		out = binary.AppendUvarint(out, uint64(len(mb)))
+		out = append(out, mb...)
+	}
+	return out
+}
+
+// decodeMiniblockRange is the inverse of encodeMiniblockRange.
+func decodeMiniblockRange(data []byte) ([][]byte, error) {
+	if !bytes.HasPrefix(data, miniblockRangeMagic) {
+		return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Bad offloaded miniblock range header")
+	}
+	data = data[len(miniblockRangeMagic):]
+
+	count, n := binary.Uvarint(data)
+	if n <= 0 || count > uint64(len(data)) {
+		return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Bad offloaded miniblock range count")
+	}
+	data = data[n:]
+
+	miniblocks := make([][]byte, 0, count)
+	for range count {
+		l, n := binary.Uvarint(data)
+		if n <= 0 || l > uint64(len(data)-n) {
+			return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Truncated offloaded miniblock range")
+		}
+		miniblocks = append(miniblocks, data[n:n+int(l)])
+		data = data[n+int(l):]
+	}
+	if len(data) != 0 {
+		return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Trailing data in offloaded miniblock range")
+	}
+	return miniblocks, nil
+}
@@ -0,0 +1,185 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+// fakeS3Server is a minimal stand-in for S3-compatible service that verifies request signatures.
+type fakeS3Server struct {
+	t         *testing.T
+	region    string
+	secretKey string
+
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func newFakeS3Server(t *testing.T, region string, secretKey string) (*fakeS3Server, *httptest.Server) {
+	f := &fakeS3Server{t: t, region: region, secretKey: secretKey, objects: make(map[string][]byte)}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	amzDate := r.Header.Get("x-amz-date")
+	payloadHash := r.Header.Get("x-amz-content-sha256")
+	if payloadHash != sha256Hex(body) || len(amzDate) < 8 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	// Server side requests carry host in the Host header.
+	r.URL.Host = r.Host
+	signature, _, _ := s3Signature(r, payloadHash, amzDate, amzDate[:8], f.region, f.secretKey)
+	if !strings.HasSuffix(r.Header.Get("Authorization"), "Signature="+signature) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		f.objects[r.URL.Path] = body
+	case http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func testObjectStore(t *testing.T, ctx context.Context, store ObjectStore) {
+	require := require.New(t)
+
+	_, err := store.GetObject(ctx, "schema/stream/1")
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+
+	require.NoError(store.PutObject(ctx, "schema/stream/1", []byte("data1")))
+	require.NoError(store.PutObject(ctx, "schema/stream/2", []byte("data2")))
+	require.NoError(store.PutObject(ctx, "schema/stream/2", []byte("data2-replaced")))
+
+	data, err := store.GetObject(ctx, "schema/stream/1")
+	require.NoError(err)
+	require.Equal([]byte("data1"), data)
+	data, err = store.GetObject(ctx, "schema/stream/2")
+	require.NoError(err)
+	require.Equal([]byte("data2-replaced"), data)
+
+	require.NoError(store.DeleteObject(ctx, "schema/stream/1"))
+	require.NoError(store.DeleteObject(ctx, "schema/stream/1"))
+	_, err = store.GetObject(ctx, "schema/stream/1")
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+}
+
+func TestFsObjectStore(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+
+	store, err := NewObjectStore(&config.ColdStorageConfig{Type: "fs", Fs: config.FsObjectStoreConfig{Path: t.TempDir()}})
+	require.NoError(t, err)
+	testObjectStore(t, ctx, store)
+
+	err = store.PutObject(ctx, "../outside", []byte("data"))
+	require.Equal(t, Err_INVALID_ARGUMENT, AsRiverError(err).Code)
+}
+
+func TestS3ObjectStore(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+
+	fake, srv := newFakeS3Server(t, "us-east-1", "secret")
+	cfg := &config.ColdStorageConfig{
+		Type: "s3",
+		S3: config.S3ObjectStoreConfig{
+			Endpoint:        srv.URL,
+			Region:          "us-east-1",
+			Bucket:          "bucket",
+			Prefix:          "river/",
+			AccessKeyId:     "key",
+			SecretAccessKey: "secret",
+		},
+	}
+	store, err := NewObjectStore(cfg)
+	require.NoError(t, err)
+	testObjectStore(t, ctx, store)
+	fake.mu.Lock()
+	require.Contains(t, fake.objects, "/bucket/river/schema/stream/2")
+	fake.mu.Unlock()
+
+	// Requests signed with wrong key are rejected.
+	cfg.S3.SecretAccessKey = "wrong"
+	store, err = NewObjectStore(cfg)
+	require.NoError(t, err)
+	_, err = store.GetObject(ctx, "schema/stream/2")
+	require.Equal(t, Err_UNAVAILABLE, AsRiverError(err).Code)
+	require.Equal(t, http.StatusForbidden, AsRiverError(err).GetTag("status"))
+
+	_, err = NewObjectStore(&config.ColdStorageConfig{Type: "s3"})
+	require.Equal(t, Err_BAD_CONFIG, AsRiverError(err).Code)
+	_, err = NewObjectStore(&config.ColdStorageConfig{Type: "gcs"})
+	require.Equal(t, Err_BAD_CONFIG, AsRiverError(err).Code)
+}
+
+func TestMiniblockRangeEncoding(t *testing.T) {
+	require := require.New(t)
+
+	miniblocks := [][]byte{[]byte("mb1"), {}, []byte(strings.Repeat("mb3", 100))}
+	data := encodeMiniblockRange(miniblocks)
+	decoded, err := decodeMiniblockRange(data)
+	require.NoError(err)
+	require.Equal([][]byte{[]byte("mb1"), {}, []byte(strings.Repeat("mb3", 100))}, decoded)
+
+	_, err = decodeMiniblockRange(data[:len(data)-1])
+	require.Equal(Err_MINIBLOCKS_STORAGE_FAILURE, AsRiverError(err).Code)
+	_, err = decodeMiniblockRange(append(data, 0))
+	require.Equal(Err_MINIBLOCKS_STORAGE_FAILURE, AsRiverError(err).Code)
+	_, err = decodeMiniblockRange([]byte("garbage"))
+	require.Equal(Err_MINIBLOCKS_STORAGE_FAILURE, AsRiverError(err).Code)
+}
+
+func TestMiniblockTimestamp(t *testing.T) {
+	require := require.New(t)
+
+	ts := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	event, err := proto.Marshal(&StreamEvent{
+		Payload: &StreamEvent_MiniblockHeader{
+			MiniblockHeader: &MiniblockHeader{MiniblockNum: 5, Timestamp: timestamppb.New(ts)},
+		},
+	})
+	require.NoError(err)
+	mb, err := proto.Marshal(&Miniblock{Header: &Envelope{Event: event}})
+	require.NoError(err)
+
+	actual, err := miniblockTimestamp(mb)
+	require.NoError(err)
+	require.True(ts.Equal(actual))
+
+	_, err = miniblockTimestamp([]byte("garbage"))
+	require.Equal(Err_BAD_BLOCK, AsRiverError(err).Code)
+}
@@ -0,0 +1,202 @@
+package storage
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+const (
+	defaultCandidateMaxAge           = time.Hour
+	maxExcessiveCandidateStreamsList = 1000
+)
+
+// MiniblockCandidatesInfo describes miniblock candidates stored for a stream.
+type MiniblockCandidatesInfo struct {
+	StreamId  StreamId
+	Count     int64
+	MinSeqNum int64
+	MaxSeqNum int64
+	Oldest    time.Time
+}
+
+// runCandidateGC periodically removes stale miniblock candidates, see CollectMiniblockCandidates.
+func (s *PostgresStreamStore) runCandidateGC(ctx context.Context, interval time.Duration) {
+	log := dlog.FromCtx(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := s.CollectMiniblockCandidates(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Warn("Miniblock candidate collection pass failed", "error", err, "deleted", count)
+				}
+				continue
+			}
+			if count > 0 {
+				log.Info("Miniblock candidate collection pass finished", "deleted", count)
+			}
+		}
+	}
+}
+
+// CollectMiniblockCandidates deletes miniblock candidates below the last committed miniblock of their stream,
+// candidates of streams that no longer exist and candidates older than the configured maximum age.
+// Candidate tables are processed one by one, each in a separate transaction.
+// It returns the number of deleted candidates.
+func (s *PostgresStreamStore) CollectMiniblockCandidates(ctx context.Context) (int64, error) {
+	maxAge := s.config.CandidateGC.MaxAge
+	if maxAge <= 0 {
+		maxAge = defaultCandidateMaxAge
+	}
+
+	tables, err := s.listStreamTables(ctx, "miniblock_candidates")
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, table := range tables {
+		var committed, expired int64
+		err := s.txRunnerWithUUIDCheck(
+			ctx,
+			"CollectMiniblockCandidates",
+			pgx.ReadWrite,
+			func(ctx context.Context, tx pgx.Tx) error {
+				var err error
+				committed, expired, err = s.collectMiniblockCandidatesTx(ctx, tx, table, maxAge)
+				return err
+			},
+			nil,
+			"table", table,
+		)
+		if err != nil {
+			return total, err
+		}
+		s.collectedCandidates.WithLabelValues("committed").Add(float64(committed))
+		s.collectedCandidates.WithLabelValues("expired").Add(float64(expired))
+		total += committed + expired
+	}
+	return total, nil
+}
+
+// collectMiniblockCandidatesTx deletes stale candidates from the given candidate table.
+// Minipools of the streams are stored in the minipool table with the same suffix,
+// the generation of the minipool is the number of the next miniblock to be committed.
+// It returns the number of candidates deleted since they were below the committed miniblock
+// or their stream is gone, and the number of candidates deleted since they expired.
+func (s *PostgresStreamStore) collectMiniblockCandidatesTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	table string,
+	maxAge time.Duration,
+) (int64, int64, error) {
+	suffix := strings.TrimPrefix(table, "miniblock_candidates_")
+	candidates := pgx.Identifier{table}.Sanitize()
+	minipools := pgx.Identifier{"minipools_" + suffix}.Sanitize()
+
+	tag, err := tx.Exec(
+		ctx,
+		fmt.Sprintf(
+			`DELETE FROM %s c WHERE NOT EXISTS (
+				SELECT 1 FROM %s p WHERE p.stream_id = c.stream_id AND p.slot_num = -1 AND p.generation <= c.seq_num
+			)`,
+			candidates,
+			minipools,
+		),
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+	committed := tag.RowsAffected()
+
+	tag, err = tx.Exec(
+		ctx,
+		fmt.Sprintf("DELETE FROM %s WHERE created_at < now() - make_interval(secs => $1)", candidates),
+		maxAge.Seconds(),
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+	return committed, tag.RowsAffected(), nil
+}
+
+// GetStreamsWithExcessiveCandidates returns streams that have at least threshold miniblock candidates,
+// ordered by the number of candidates. If threshold is not positive, the configured value is used.
+func (s *PostgresStreamStore) GetStreamsWithExcessiveCandidates(
+	ctx context.Context,
+	threshold int,
+) ([]*MiniblockCandidatesInfo, error) {
+	if threshold <= 0 {
+		threshold = s.config.CandidateGC.GetExcessiveCandidates()
+	}
+
+	tables, err := s.listStreamTables(ctx, "miniblock_candidates")
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*MiniblockCandidatesInfo
+	err = s.txRunner(
+		ctx,
+		"GetStreamsWithExcessiveCandidates",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			ret = nil
+			for _, table := range tables {
+				rows, _ := tx.Query(
+					ctx,
+					fmt.Sprintf(
+						`SELECT stream_id, COUNT(*), MIN(seq_num), MAX(seq_num), MIN(created_at) FROM %s
+						GROUP BY stream_id HAVING COUNT(*) >= $1`,
+						pgx.Identifier{table}.Sanitize(),
+					),
+					threshold,
+				)
+				var info MiniblockCandidatesInfo
+				_, err := pgx.ForEachRow(
+					rows,
+					[]any{&info.StreamId, &info.Count, &info.MinSeqNum, &info.MaxSeqNum, &info.Oldest},
+					func() error {
+						infoCopy := info
+						ret = append(ret, &infoCopy)
+						return nil
+					},
+				)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		nil,
+		"threshold", threshold,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(ret, func(a, b *MiniblockCandidatesInfo) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return a.Oldest.Compare(b.Oldest)
+	})
+	if len(ret) > maxExcessiveCandidateStreamsList {
+		ret = ret[:maxExcessiveCandidateStreamsList]
+	}
+	return ret, nil
+}
@@ -0,0 +1,395 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+const defaultOffloadRangeSize = 100
+
+// offloadedRange is an index row of the miniblock range stored in cold storage.
+type offloadedRange struct {
+	firstSeqNum int64
+	lastSeqNum  int64
+	objectKey   string
+}
+
+// runOffload periodically moves old miniblocks of all streams to cold storage.
+func (s *PostgresStreamStore) runOffload(ctx context.Context, interval time.Duration) {
+	log := dlog.FromCtx(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := s.OffloadMiniblocks(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Warn("Miniblock offload pass failed", "error", err, "offloaded", count)
+				}
+				continue
+			}
+			if count > 0 {
+				log.Info("Miniblock offload pass finished", "offloaded", count)
+			}
+		}
+	}
+}
+
+// OffloadMiniblocks moves old miniblocks of all streams to cold storage.
+// It returns the number of offloaded miniblocks.
+func (s *PostgresStreamStore) OffloadMiniblocks(ctx context.Context) (int, error) {
+	if s.objectStore == nil {
+		return 0, nil
+	}
+
+	streams, err := s.GetStreams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, streamId := range streams {
+		count, err := s.OffloadStreamMiniblocks(ctx, streamId)
+		total += count
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// OffloadStreamMiniblocks moves miniblocks of the given stream that are eligible for offloading to cold storage.
+// Miniblocks are offloaded in ranges of ColdStorageConfig.RangeSize, each range is stored as a single object.
+// It returns the number of offloaded miniblocks.
+func (s *PostgresStreamStore) OffloadStreamMiniblocks(ctx context.Context, streamId StreamId) (int, error) {
+	if s.objectStore == nil {
+		return 0, nil
+	}
+
+	total := 0
+	for {
+		count, err := s.offloadNextRange(ctx, streamId)
+		total += count
+		if err != nil || count == 0 {
+			return total, err
+		}
+	}
+}
+
+func (s *PostgresStreamStore) offloadNextRange(ctx context.Context, streamId StreamId) (int, error) {
+	var blobs [][]byte
+	var firstSeqNum int64
+	err := s.txRunnerWithUUIDCheck(
+		ctx,
+		"OffloadMiniblocks.read",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			firstSeqNum, blobs, err = s.readOffloadCandidatesTx(ctx, tx, streamId)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+	)
+	if err != nil || len(blobs) == 0 {
+		return 0, err
+	}
+
+	r := offloadedRange{
+		firstSeqNum: firstSeqNum,
+		lastSeqNum:  firstSeqNum + int64(len(blobs)) - 1,
+	}
+	r.objectKey = fmt.Sprintf("%s/%s/%020d-%020d", s.schemaName, streamId, r.firstSeqNum, r.lastSeqNum)
+
+	if err := s.objectStore.PutObject(ctx, r.objectKey, encodeMiniblockRange(blobs)); err != nil {
+		return 0, AsRiverError(err).Func("OffloadStreamMiniblocks").Tag("streamId", streamId)
+	}
+
+	err = s.txRunnerWithUUIDCheck(
+		ctx,
+		"OffloadMiniblocks.commit",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.commitOffloadedRangeTx(ctx, tx, streamId, &r)
+		},
+		nil,
+		"streamId", streamId,
+		"firstSeqNum", r.firstSeqNum,
+		"lastSeqNum", r.lastSeqNum,
+	)
+	if err != nil {
+		// Miniblocks are still in the database, remove the unreferenced object.
+		_ = s.objectStore.DeleteObject(ctx, r.objectKey)
+		return 0, err
+	}
+
+	s.offloadedMiniblocks.Add(float64(len(blobs)))
+	return len(blobs), nil
+}
+
+// readOffloadCandidatesTx returns the oldest range of miniblocks in the database if it is eligible for offloading.
+// Miniblocks are returned as stored, i.e. possibly compressed.
+func (s *PostgresStreamStore) readOffloadCandidatesTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+) (int64, [][]byte, error) {
+	lastSnapshotMiniblock, layout, err := s.lockStream(ctx, tx, streamId, false)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	cfg := &s.config.ColdStorage
+	rangeSize := cfg.RangeSize
+	if rangeSize <= 0 {
+		rangeSize = defaultOffloadRangeSize
+	}
+
+	// Miniblocks starting from the last snapshot are required to load the stream and are never offloaded.
+	limitSeqNum := lastSnapshotMiniblock - int64(max(0, cfg.KeepMiniblocks))
+
+	rows, _ := tx.Query(
+		ctx,
+		s.sqlForStream(
+			"SELECT seq_num, blockdata FROM {{miniblocks}} WHERE stream_id = $1 AND seq_num < $2 ORDER BY seq_num LIMIT $3",
+			streamId,
+			layout,
+		),
+		streamId,
+		limitSeqNum,
+		rangeSize,
+	)
+	type mbRow struct {
+		seqNum    int64
+		blockdata []byte
+	}
+	mbRows, err := pgx.CollectRows(
+		rows,
+		func(row pgx.CollectableRow) (mbRow, error) {
+			var r mbRow
+			err := row.Scan(&r.seqNum, &r.blockdata)
+			return r, err
+		},
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Only full ranges are offloaded.
+	if len(mbRows) < rangeSize {
+		return 0, nil, nil
+	}
+
+	blobs := make([][]byte, len(mbRows))
+	for i, r := range mbRows {
+		if r.seqNum != mbRows[0].seqNum+int64(i) {
+			return 0, nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
+				Tag("ActualBlockNumber", r.seqNum).Tag("ExpectedBlockNumber", mbRows[0].seqNum+int64(i))
+		}
+		blobs[i] = r.blockdata
+	}
+
+	if cfg.MinAge > 0 {
+		lastMb, err := s.codec.decode(blobs[len(blobs)-1])
+		if err != nil {
+			return 0, nil, err
+		}
+		ts, err := miniblockTimestamp(lastMb)
+		if err != nil {
+			return 0, nil, AsRiverError(err).Tag("seqNum", mbRows[len(mbRows)-1].seqNum)
+		}
+		if time.Since(ts) < cfg.MinAge {
+			return 0, nil, nil
+		}
+	}
+
+	return mbRows[0].seqNum, blobs, nil
+}
+
+func (s *PostgresStreamStore) commitOffloadedRangeTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	r *offloadedRange,
+) error {
+	_, layout, err := s.lockStream(ctx, tx, streamId, true)
+	if err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		s.sqlForStream(
+			"DELETE FROM {{miniblocks}} WHERE stream_id = $1 AND seq_num >= $2 AND seq_num <= $3",
+			streamId,
+			layout,
+		),
+		streamId,
+		r.firstSeqNum,
+		r.lastSeqNum,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() != r.lastSeqNum-r.firstSeqNum+1 {
+		return RiverError(Err_INTERNAL, "Miniblocks changed while being offloaded").
+			Tag("deleted", tag.RowsAffected())
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`INSERT INTO offloaded_miniblocks (stream_id, first_seq_num, last_seq_num, object_key)
+		VALUES ($1, $2, $3, $4)`,
+		streamId,
+		r.firstSeqNum,
+		r.lastSeqNum,
+		r.objectKey,
+	)
+	return err
+}
+
+// readOffloadedRangesTx returns offloaded ranges that intersect with [fromInclusive, toExclusive).
+func (s *PostgresStreamStore) readOffloadedRangesTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([]offloadedRange, error) {
+	rows, _ := tx.Query(
+		ctx,
+		`SELECT first_seq_num, last_seq_num, object_key FROM offloaded_miniblocks
+		WHERE stream_id = $1 AND last_seq_num >= $2 AND first_seq_num < $3 ORDER BY first_seq_num`,
+		streamId,
+		fromInclusive,
+		toExclusive,
+	)
+	return pgx.CollectRows(
+		rows,
+		func(row pgx.CollectableRow) (offloadedRange, error) {
+			var r offloadedRange
+			err := row.Scan(&r.firstSeqNum, &r.lastSeqNum, &r.objectKey)
+			return r, err
+		},
+	)
+}
+
+// offloadedCount returns the number of miniblocks in [fromInclusive, toExclusive) covered by the given ranges.
+func offloadedCount(ranges []offloadedRange, fromInclusive int64, toExclusive int64) int64 {
+	var count int64
+	for _, r := range ranges {
+		count += max(0, min(r.lastSeqNum+1, toExclusive)-max(r.firstSeqNum, fromInclusive))
+	}
+	return count
+}
+
+// readOffloadedMiniblocks fetches miniblocks in [fromInclusive, toExclusive) from the given offloaded ranges.
+func (s *PostgresStreamStore) readOffloadedMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	ranges []offloadedRange,
+	fromInclusive int64,
+	toExclusive int64,
+) ([][]byte, error) {
+	if s.objectStore == nil {
+		return nil, RiverError(
+			Err_UNAVAILABLE,
+			"Miniblocks are offloaded to cold storage, but cold storage is not configured",
+		).Func("readOffloadedMiniblocks").Tag("streamId", streamId)
+	}
+
+	var miniblocks [][]byte
+	expectedSeqNum := fromInclusive
+	for _, r := range ranges {
+		if r.firstSeqNum > expectedSeqNum {
+			return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
+				Tag("ActualBlockNumber", r.firstSeqNum).Tag("ExpectedBlockNumber", expectedSeqNum).
+				Tag("streamId", streamId).Func("readOffloadedMiniblocks")
+		}
+
+		data, err := s.objectStore.GetObject(ctx, r.objectKey)
+		if err != nil {
+			return nil, AsRiverError(err).Func("readOffloadedMiniblocks").Tag("streamId", streamId)
+		}
+		s.coldReads.Inc()
+
+		blobs, err := decodeMiniblockRange(data)
+		if err != nil {
+			return nil, AsRiverError(err).Func("readOffloadedMiniblocks").Tag("key", r.objectKey)
+		}
+		if int64(len(blobs)) != r.lastSeqNum-r.firstSeqNum+1 {
+			return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Offloaded miniblock range size mismatch").
+				Tag("key", r.objectKey).Tag("size", len(blobs)).Func("readOffloadedMiniblocks")
+		}
+
+		for seqNum := expectedSeqNum; seqNum <= r.lastSeqNum && seqNum < toExclusive; seqNum++ {
+			mb, err := s.codec.decode(blobs[seqNum-r.firstSeqNum])
+			if err != nil {
+				return nil, err
+			}
+			miniblocks = append(miniblocks, mb)
+			expectedSeqNum = seqNum + 1
+		}
+	}
+	return miniblocks, nil
+}
+
+// deleteOffloadedRangesTx removes index rows of the stream ranges that end before beforeSeqNum
+// and returns keys of objects to delete.
+func (s *PostgresStreamStore) deleteOffloadedRangesTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	beforeSeqNum int64,
+) ([]string, error) {
+	rows, _ := tx.Query(
+		ctx,
+		"DELETE FROM offloaded_miniblocks WHERE stream_id = $1 AND last_seq_num < $2 RETURNING object_key",
+		streamId,
+		beforeSeqNum,
+	)
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// deleteOffloadedObjects deletes objects of offloaded ranges removed from the index.
+// Failures are only logged: objects that are not referenced by the index are never read.
+func (s *PostgresStreamStore) deleteOffloadedObjects(ctx context.Context, objectKeys []string) {
+	if s.objectStore == nil {
+		return
+	}
+	for _, key := range objectKeys {
+		if err := s.objectStore.DeleteObject(ctx, key); err != nil {
+			dlog.FromCtx(ctx).Warn("Failed to delete offloaded miniblocks", "key", key, "error", err)
+		}
+	}
+}
+
+// miniblockTimestamp returns the timestamp from the header of the serialized miniblock.
+func miniblockTimestamp(data []byte) (time.Time, error) {
+	var mb Miniblock
+	if err := proto.Unmarshal(data, &mb); err != nil {
+		return time.Time{}, AsRiverError(err, Err_BAD_BLOCK).Message("Failed to parse miniblock")
+	}
+	var event StreamEvent
+	if err := proto.Unmarshal(mb.GetHeader().GetEvent(), &event); err != nil {
+		return time.Time{}, AsRiverError(err, Err_BAD_BLOCK).Message("Failed to parse miniblock header")
+	}
+	header := event.GetMiniblockHeader()
+	if header == nil || header.GetTimestamp() == nil {
+		return time.Time{}, RiverError(Err_BAD_BLOCK, "Miniblock header has no timestamp")
+	}
+	return header.GetTimestamp().AsTime(), nil
+}
@@ -0,0 +1,203 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// runRecompression periodically rewrites miniblocks that are stored uncompressed,
+// i.e. written before compression was enabled, with the configured codec.
+func (s *PostgresStreamStore) runRecompression(ctx context.Context, interval time.Duration) {
+	log := dlog.FromCtx(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := s.RecompressMiniblocks(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Warn("Miniblock recompression pass failed", "error", err, "recompressed", count)
+				}
+				continue
+			}
+			if count > 0 {
+				log.Info("Miniblock recompression pass finished", "recompressed", count)
+			}
+		}
+	}
+}
+
+// RecompressMiniblocks rewrites uncompressed miniblocks of all streams with the configured codec.
+// It returns the number of rewritten miniblocks.
+func (s *PostgresStreamStore) RecompressMiniblocks(ctx context.Context) (int, error) {
+	if !s.codec.enabled() {
+		return 0, nil
+	}
+
+	streams, err := s.GetStreams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, streamId := range streams {
+		count, err := s.RecompressStreamMiniblocks(ctx, streamId)
+		total += count
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// RecompressStreamMiniblocks rewrites uncompressed miniblocks of the given stream with the configured codec.
+// Miniblocks are processed in batches, each batch is written in a separate transaction.
+func (s *PostgresStreamStore) RecompressStreamMiniblocks(ctx context.Context, streamId StreamId) (int, error) {
+	if !s.codec.enabled() {
+		return 0, nil
+	}
+
+	batchSize := s.config.Compression.RecompressionBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultRecompressionBatchSize
+	}
+
+	total := 0
+	fromSeqNum := int64(0)
+	for {
+		var count int
+		var done bool
+		err := s.txRunnerWithUUIDCheck(
+			ctx,
+			"RecompressStreamMiniblocks",
+			pgx.ReadWrite,
+			func(ctx context.Context, tx pgx.Tx) error {
+				var err error
+				count, fromSeqNum, done, err = s.recompressMiniblocksTx(ctx, tx, streamId, fromSeqNum, batchSize)
+				return err
+			},
+			nil,
+			"streamId", streamId,
+			"fromSeqNum", fromSeqNum,
+		)
+		if err != nil {
+			return total, err
+		}
+		total += count
+		s.recompressedMiniblocks.Add(float64(count))
+		if done {
+			return total, nil
+		}
+	}
+}
+
+// recompressMiniblocksTx rewrites up to batchSize uncompressed miniblocks starting from fromSeqNum.
+// Miniblocks that don't compress are rewritten with the raw codec header, so they are not checked again.
+// It returns the number of rewritten miniblocks, the sequence number to continue from,
+// and true if there are no more miniblocks to check.
+func (s *PostgresStreamStore) recompressMiniblocksTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	fromSeqNum int64,
+	batchSize int,
+) (int, int64, bool, error) {
+	const candidatesFilter = `WHERE stream_id = $1 AND seq_num >= $2 AND length(blockdata) >= $3
+		AND substring(blockdata from 1 for 1) <> '\x00'::bytea`
+
+	// Check for uncompressed miniblocks before taking the lock,
+	// so streams that are already recompressed are not blocked for writes.
+	_, layout, err := s.readStreamLayoutNoLock(ctx, tx, streamId)
+	if err != nil {
+		return 0, fromSeqNum, false, err
+	}
+	var found bool
+	err = tx.QueryRow(
+		ctx,
+		s.sqlForStream(
+			"SELECT EXISTS (SELECT 1 FROM {{miniblocks}} "+candidatesFilter+")",
+			streamId,
+			layout,
+		),
+		streamId,
+		fromSeqNum,
+		s.codec.minSize,
+	).Scan(&found)
+	if err != nil {
+		return 0, fromSeqNum, false, err
+	}
+	if !found {
+		return 0, fromSeqNum, true, nil
+	}
+
+	_, layout, err = s.lockStream(ctx, tx, streamId, true)
+	if err != nil {
+		return 0, fromSeqNum, false, err
+	}
+
+	type mbRow struct {
+		seqNum    int64
+		blockdata []byte
+	}
+	rows, _ := tx.Query(
+		ctx,
+		s.sqlForStream(
+			"SELECT seq_num, blockdata FROM {{miniblocks}} "+candidatesFilter+" ORDER BY seq_num LIMIT $4",
+			streamId,
+			layout,
+		),
+		streamId,
+		fromSeqNum,
+		s.codec.minSize,
+		batchSize,
+	)
+	mbRows, err := pgx.CollectRows(
+		rows,
+		func(row pgx.CollectableRow) (mbRow, error) {
+			var r mbRow
+			err := row.Scan(&r.seqNum, &r.blockdata)
+			return r, err
+		},
+	)
+	if err != nil {
+		return 0, fromSeqNum, false, err
+	}
+	if len(mbRows) == 0 {
+		return 0, fromSeqNum, true, nil
+	}
+
+	count := 0
+	for _, r := range mbRows {
+		encoded := s.codec.encode(blobKindMiniblock, streamId, r.blockdata)
+		if !isEncodedBlob(encoded) {
+			// Data doesn't compress, mark it as raw.
+			encoded = rawBlob(r.blockdata)
+		}
+		_, err = tx.Exec(
+			ctx,
+			s.sqlForStream(
+				"UPDATE {{miniblocks}} SET blockdata = $1 WHERE stream_id = $2 AND seq_num = $3",
+				streamId,
+				layout,
+			),
+			encoded,
+			streamId,
+			r.seqNum,
+		)
+		if err != nil {
+			return 0, fromSeqNum, false, err
+		}
+		count++
+	}
+
+	return count, mbRows[len(mbRows)-1].seqNum + 1, len(mbRows) < batchSize, nil
+}
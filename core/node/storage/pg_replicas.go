@@ -0,0 +1,265 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+const (
+	defaultReplicaMaxLag           = 5 * time.Second
+	defaultReplicaLagCheckInterval = 5 * time.Second
+
+	// replicaUnavailable is stored as lag of replicas that failed the last lag check.
+	replicaUnavailable = time.Duration(math.MaxInt64)
+)
+
+// pgReadReplica is a read replica of the database.
+type pgReadReplica struct {
+	index int
+	pool  *pgxpool.Pool
+	// lag observed by the last lag check.
+	lag atomic.Int64
+}
+
+// pgReadReplicas routes read-only transactions to replicas that are not lagging behind the primary
+// more than maxLag, in round-robin order.
+type pgReadReplicas struct {
+	replicas []*pgReadReplica
+	maxLag   time.Duration
+	interval time.Duration
+	next     atomic.Uint32
+
+	lagGauge *prometheus.GaugeVec
+	reads    *prometheus.CounterVec
+}
+
+func newPgReadReplicas(poolInfo *PgxPoolInfo, metrics infra.MetricsFactory) *pgReadReplicas {
+	if len(poolInfo.ReplicaPools) == 0 {
+		return nil
+	}
+
+	cfg := &poolInfo.Config.ReadReplicas
+	r := &pgReadReplicas{
+		maxLag:   cfg.MaxLag,
+		interval: cfg.LagCheckInterval,
+		lagGauge: metrics.NewGaugeVecEx(
+			"postgres_replica_lag_seconds",
+			"Replication lag of the read replica observed by the last check, -1 if replica is unavailable",
+			"replica",
+		),
+		reads: metrics.NewCounterVecEx(
+			"postgres_replica_reads",
+			"Read-only transactions by the database they were served from",
+			"name", "result",
+		),
+	}
+	if r.maxLag <= 0 {
+		r.maxLag = defaultReplicaMaxLag
+	}
+	if r.interval <= 0 {
+		r.interval = defaultReplicaLagCheckInterval
+	}
+
+	for i, pool := range poolInfo.ReplicaPools {
+		replica := &pgReadReplica{index: i, pool: pool}
+		// Replicas are not used until the first lag check.
+		replica.lag.Store(int64(replicaUnavailable))
+		r.replicas = append(r.replicas, replica)
+	}
+	return r
+}
+
+func (r *pgReadReplicas) close() {
+	if r == nil {
+		return
+	}
+	for _, replica := range r.replicas {
+		replica.pool.Close()
+	}
+}
+
+// queryReplicationLag returns the replication lag of the given replica.
+// The lag is 0 if the replica has replayed all received WAL or is not in recovery.
+func queryReplicationLag(ctx context.Context, pool *pgxpool.Pool) (time.Duration, error) {
+	var lagSeconds float64
+	err := pool.QueryRow(
+		ctx,
+		`SELECT CASE
+			WHEN NOT pg_is_in_recovery() THEN 0
+			WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+		END::FLOAT8`,
+	).Scan(&lagSeconds)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(lagSeconds * float64(time.Second)), nil
+}
+
+func (r *pgReadReplicas) checkLag(ctx context.Context) {
+	log := dlog.FromCtx(ctx)
+	for _, replica := range r.replicas {
+		checkCtx, cancel := context.WithTimeout(ctx, r.interval)
+		lag, err := queryReplicationLag(checkCtx, replica.pool)
+		cancel()
+
+		label := fmt.Sprint(replica.index)
+		if err != nil {
+			if replica.lag.Swap(int64(replicaUnavailable)) != int64(replicaUnavailable) && ctx.Err() == nil {
+				log.Warn("Read replica is unavailable", "replica", replica.index, "error", err)
+			}
+			r.lagGauge.WithLabelValues(label).Set(-1)
+			continue
+		}
+		replica.lag.Store(int64(lag))
+		r.lagGauge.WithLabelValues(label).Set(lag.Seconds())
+	}
+}
+
+// runLagMonitor periodically checks replication lag of all replicas.
+func (r *pgReadReplicas) runLagMonitor(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	r.checkLag(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.checkLag(ctx)
+		}
+	}
+}
+
+// pick returns the next replica with lag within maxLag, or nil if there is none.
+func (r *pgReadReplicas) pick() *pgReadReplica {
+	if r == nil {
+		return nil
+	}
+	start := r.next.Add(1)
+	for i := range r.replicas {
+		replica := r.replicas[(int(start)+i)%len(r.replicas)]
+		if time.Duration(replica.lag.Load()) <= r.maxLag {
+			return replica
+		}
+	}
+	return nil
+}
+
+func (r *pgReadReplicas) runTx(
+	ctx context.Context,
+	replica *pgReadReplica,
+	txFn func(context.Context, pgx.Tx) error,
+) error {
+	// Hot standby doesn't support serializable transactions.
+	tx, err := replica.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(ctx, tx)
+
+	err = txFn(ctx, tx)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// replicaBehindError is returned by read functions running on a replica
+// if the replica doesn't have all requested data yet.
+func replicaBehindError() *RiverErrorImpl {
+	return RiverError(Err_UNAVAILABLE, "Read replica is behind the requested data")
+}
+
+// replicaTxRunner runs read-only txFn on a read replica if one with acceptable lag is available.
+// txFn is told if it runs on a replica: it can't lock rows there and should return replicaBehindError
+// if data read from the replica is incomplete. If there is no replica or txFn fails on it,
+// txFn is run on the primary with the UUID check.
+func (s *PostgresStreamStore) replicaTxRunner(
+	ctx context.Context,
+	name string,
+	txFn func(ctx context.Context, tx pgx.Tx, replica bool) error,
+	opts *txRunnerOpts,
+	tags ...any,
+) error {
+	if replica := s.replicas.pick(); replica != nil {
+		err := s.replicas.runTx(ctx, replica, func(ctx context.Context, tx pgx.Tx) error {
+			return txFn(ctx, tx, true)
+		})
+		if err == nil {
+			s.replicas.reads.WithLabelValues(name, "replica").Inc()
+			return nil
+		}
+		if ctx.Err() != nil {
+			return AsRiverError(ctx.Err()).Func(name)
+		}
+		s.replicas.reads.WithLabelValues(name, "fallback").Inc()
+		dlog.FromCtx(ctx).Debug(
+			"pg.replicaTxRunner: falling back to primary",
+			append(tags, "name", name, "replica", replica.index, "error", err)...,
+		)
+	}
+
+	return s.txRunnerWithUUIDCheck(
+		ctx,
+		name,
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return txFn(ctx, tx, false)
+		},
+		opts,
+		tags...,
+	)
+}
+
+// readStreamLayout returns the last snapshot miniblock and layout of the stream.
+// On the primary the stream record is share-locked, replicas don't support row locks.
+func (s *PostgresStreamStore) readStreamLayout(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	replica bool,
+) (int64, streamLayout, error) {
+	if !replica {
+		return s.lockStream(ctx, tx, streamId, false)
+	}
+	return s.readStreamLayoutNoLock(ctx, tx, streamId)
+}
+
+// readStreamLayoutNoLock returns the last snapshot miniblock and layout of the stream
+// without locking the stream record.
+func (s *PostgresStreamStore) readStreamLayoutNoLock(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+) (int64, streamLayout, error) {
+	var lastSnapshotMiniblock int64
+	var layout streamLayout
+	err := tx.QueryRow(
+		ctx,
+		"SELECT latest_snapshot_miniblock, migrated, num_partitions, first_miniblock from es WHERE stream_id = $1",
+		streamId,
+	).Scan(&lastSnapshotMiniblock, &layout.migrated, &layout.numPartitions, &layout.firstMiniblock)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, streamLayout{}, RiverError(Err_NOT_FOUND, "Stream not found", "streamId", streamId)
+		}
+		return 0, streamLayout{}, err
+	}
+	return lastSnapshotMiniblock, layout, nil
+}
@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// PruneMiniblocks deletes miniblocks preceding the retainSnapshots-th most recent snapshot miniblock,
+// including miniblocks offloaded to cold storage.
+func (s *PostgresStreamStore) PruneMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	retainSnapshots int,
+) (int64, error) {
+	var firstMiniblock int64
+	var objectKeys []string
+	err := s.txRunnerWithUUIDCheck(
+		ctx,
+		"PruneMiniblocks",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			firstMiniblock, objectKeys, err = s.pruneMiniblocksTx(ctx, tx, streamId, retainSnapshots)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+		"retainSnapshots", retainSnapshots,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	s.deleteOffloadedObjects(ctx, objectKeys)
+	return firstMiniblock, nil
+}
+
+func (s *PostgresStreamStore) pruneMiniblocksTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	retainSnapshots int,
+) (int64, []string, error) {
+	_, layout, err := s.lockStream(ctx, tx, streamId, true)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	firstMiniblock := layout.firstMiniblock
+	if retainSnapshots <= 0 {
+		return firstMiniblock, nil, nil
+	}
+
+	rows, _ := tx.Query(
+		ctx,
+		"SELECT seq_num FROM stream_snapshots WHERE stream_id = $1 ORDER BY seq_num DESC LIMIT $2",
+		streamId,
+		retainSnapshots,
+	)
+	snapshots, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(snapshots) < retainSnapshots || snapshots[len(snapshots)-1] <= firstMiniblock {
+		return firstMiniblock, nil, nil
+	}
+	boundary := snapshots[len(snapshots)-1]
+
+	_, err = tx.Exec(
+		ctx,
+		s.sqlForStream(
+			"DELETE FROM {{miniblocks}} WHERE stream_id = $1 AND seq_num < $2",
+			streamId,
+			layout,
+		),
+		streamId,
+		boundary,
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	objectKeys, err := s.deleteOffloadedRangesTx(ctx, tx, streamId, boundary)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`DELETE FROM stream_snapshots WHERE stream_id = $1 AND seq_num < $2;
+		UPDATE es SET first_miniblock = $2 WHERE stream_id = $1`,
+		streamId,
+		boundary,
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	s.prunedMiniblocks.Add(float64(boundary - firstMiniblock))
+	return boundary, objectKeys, nil
+}
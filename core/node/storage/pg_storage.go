@@ -42,6 +42,9 @@ type PostgresEventStore struct {
 	txDuration *prometheus.HistogramVec
 
 	isolationLevel pgx.TxIsoLevel
+
+	// replicas is nil if read replicas are not configured.
+	replicas *pgReadReplicas
 }
 
 // var _ StreamStorage = (*PostgresEventStore)(nil)
@@ -176,19 +179,20 @@ type PgxPoolInfo struct {
 	Url        string
 	Schema     string
 	Config     *config.DatabaseConfig
+
+	// ReplicaPools are pools of read replicas configured by Config.ReadReplicas.
+	ReplicaPools []*pgxpool.Pool
 }
 
-func createAndValidatePgxPool(
+func newPgxPool(
 	ctx context.Context,
-	cfg *config.DatabaseConfig,
+	databaseUrl string,
 	databaseSchemaName string,
 	tracerProvider trace.TracerProvider,
-) (*PgxPoolInfo, error) {
-	databaseUrl := cfg.GetUrl()
-
+) (*pgxpool.Pool, *pgxpool.Config, error) {
 	poolConf, err := pgxpool.ParseConfig(databaseUrl)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// In general, it should be possible to add database schema name into database url as a parameter search_path (&search_path=database_schema_name)
@@ -206,21 +210,56 @@ func createAndValidatePgxPool(
 	}
 
 	pool, err := pgxpool.NewWithConfig(ctx, poolConf)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pool, poolConf, nil
+}
+
+func createAndValidatePgxPool(
+	ctx context.Context,
+	cfg *config.DatabaseConfig,
+	databaseSchemaName string,
+	tracerProvider trace.TracerProvider,
+) (*PgxPoolInfo, error) {
+	databaseUrl := cfg.GetUrl()
+
+	pool, poolConf, err := newPgxPool(ctx, databaseUrl, databaseSchemaName, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 
 	err = pool.Ping(ctx)
 	if err != nil {
+		pool.Close()
 		return nil, err
 	}
 
+	// Unavailable replicas don't prevent the node from starting, they are skipped
+	// until the lag check succeeds.
+	var replicaPools []*pgxpool.Pool
+	for i, replicaUrl := range cfg.ReadReplicas.GetUrls() {
+		replicaPool, _, err := newPgxPool(ctx, replicaUrl, databaseSchemaName, tracerProvider)
+		if err != nil {
+			pool.Close()
+			for _, p := range replicaPools {
+				p.Close()
+			}
+			return nil, AsRiverError(err).Message("Failed to create read replica pool").Tag("replica", i)
+		}
+		if err = replicaPool.Ping(ctx); err != nil {
+			dlog.FromCtx(ctx).Warn("Read replica is not available", "replica", i, "error", err)
+		}
+		replicaPools = append(replicaPools, replicaPool)
+	}
+
 	return &PgxPoolInfo{
-		Pool:       pool,
-		PoolConfig: poolConf,
-		Url:        databaseUrl,
-		Schema:     databaseSchemaName,
-		Config:     cfg,
+		Pool:         pool,
+		PoolConfig:   poolConf,
+		Url:          databaseUrl,
+		Schema:       databaseSchemaName,
+		Config:       cfg,
+		ReplicaPools: replicaPools,
 	}, nil
 }
 
@@ -269,6 +308,19 @@ type PostgresStatusResult struct {
 	MigratedStreams   int64
 	UnmigratedStreams int64
 	NumPartitions     int64
+
+	// RepartitionPendingStreams is the number of migrated streams not yet moved to the
+	// current partition layout by repartitioning.
+	RepartitionPendingStreams int64
+
+	ReadReplicas []PostgresReplicaStatus `json:"read_replicas,omitempty"`
+}
+
+type PostgresReplicaStatus struct {
+	Replica    int     `json:"replica"`
+	TotalConns int32   `json:"total_conns"`
+	LagSeconds float64 `json:"lag_seconds"`
+	Error      string  `json:"error,omitempty"`
 }
 
 func PreparePostgresStatus(ctx context.Context, pool PgxPoolInfo) PostgresStatusResult {
@@ -317,6 +369,20 @@ func PreparePostgresStatus(ctx context.Context, pool PgxPoolInfo) PostgresStatus
 		}
 	}
 
+	var repartitionPendingStreams int64
+	err = pool.Pool.QueryRow(
+		ctx,
+		`SELECT count(*) FROM es WHERE migrated = true
+		AND num_partitions <> (SELECT num_partitions FROM settings WHERE single_row_key = true)`,
+	).Scan(&repartitionPendingStreams)
+	if err != nil {
+		// Ignore nonexistent table or missing column, which occurs when stats are collected before migration completes
+		if pgerr, ok := err.(*pgconn.PgError); ok && pgerr.Code != pgerrcode.UndefinedTable &&
+			pgerr.Code != pgerrcode.UndefinedColumn {
+			log.Error("Error calculating repartition pending stream count", "error", err)
+		}
+	}
+
 	return PostgresStatusResult{
 		TotalConns:              poolStat.TotalConns(),
 		AcquiredConns:           poolStat.AcquiredConns(),
@@ -335,7 +401,28 @@ func PreparePostgresStatus(ctx context.Context, pool PgxPoolInfo) PostgresStatus
 		MigratedStreams:         migratedStreams,
 		UnmigratedStreams:       unmigratedStreams,
 		NumPartitions:           numPartitions,
+
+		RepartitionPendingStreams: repartitionPendingStreams,
+		ReadReplicas:              prepareReplicasStatus(ctx, pool.ReplicaPools),
+	}
+}
+
+func prepareReplicasStatus(ctx context.Context, pools []*pgxpool.Pool) []PostgresReplicaStatus {
+	var result []PostgresReplicaStatus
+	for i, pool := range pools {
+		status := PostgresReplicaStatus{
+			Replica:    i,
+			TotalConns: pool.Stat().TotalConns(),
+		}
+		lag, err := queryReplicationLag(ctx, pool)
+		if err != nil {
+			status.Error = err.Error()
+		} else {
+			status.LagSeconds = lag.Seconds()
+		}
+		result = append(result, status)
 	}
+	return result
 }
 
 func SetupPostgresMetrics(ctx context.Context, pool PgxPoolInfo, factory infra.MetricsFactory) {
@@ -425,6 +512,11 @@ func SetupPostgresMetrics(ctx context.Context, pool PgxPoolInfo, factory infra.M
 			"Total partitions used in fixed partition schema layout",
 			func(s PostgresStatusResult) float64 { return float64(s.NumPartitions) },
 		},
+		{
+			"postgres_repartition_pending_streams",
+			"Total migrated streams not yet moved to the current partition layout",
+			func(s PostgresStatusResult) float64 { return float64(s.RepartitionPendingStreams) },
+		},
 	}
 
 	for _, metric := range numericMetrics {
@@ -509,19 +601,31 @@ func (s *PostgresEventStore) init(
 	preMigrationTxn func(context.Context, pgx.Tx) error,
 	migrations fs.FS,
 ) error {
+	s.setup(ctx, poolInfo, metrics)
+
+	s.preMigrationTx = preMigrationTxn
+	s.migrationDir = migrations
+
+	return s.InitStorage(ctx)
+}
+
+// setup initializes the store without creating the schema or running migrations.
+func (s *PostgresEventStore) setup(
+	ctx context.Context,
+	poolInfo *PgxPoolInfo,
+	metrics infra.MetricsFactory,
+) {
 	log := dlog.FromCtx(ctx)
 
 	SetupPostgresMetrics(ctx, *poolInfo, metrics)
 
 	s.config = poolInfo.Config
 	s.pool = poolInfo.Pool
+	s.replicas = newPgReadReplicas(poolInfo, metrics)
 	s.poolConfig = poolInfo.PoolConfig
 	s.schemaName = poolInfo.Schema
 	s.dbUrl = poolInfo.Url
 
-	s.preMigrationTx = preMigrationTxn
-	s.migrationDir = migrations
-
 	s.txCounter = metrics.NewStatusCounterVecEx("dbtx_status", "PG transaction status", "name")
 	s.txDuration = metrics.NewHistogramVecEx(
 		"dbtx_duration_seconds",
@@ -544,18 +648,12 @@ func (s *PostgresEventStore) init(
 	if s.isolationLevel != pgx.Serializable {
 		log.Info("PostgresEventStore: using isolation level", "level", s.isolationLevel)
 	}
-
-	err := s.InitStorage(ctx)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
 
 // Close closes the connection pool
 func (s *PostgresEventStore) Close(ctx context.Context) {
 	s.pool.Close()
+	s.replicas.close()
 }
 
 func (s *PostgresEventStore) InitStorage(ctx context.Context) error {
@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// listStreamTables returns names of all tables that store rows of the given partitioned table
+// ("miniblocks", "minipools" or "miniblock_candidates"): fixed partitions and tables of legacy streams.
+func (s *PostgresStreamStore) listStreamTables(ctx context.Context, table string) ([]string, error) {
+	var tables []string
+	err := s.txRunner(
+		ctx,
+		"listStreamTables",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			tables, err = s.listStreamTablesTx(ctx, tx, table)
+			return err
+		},
+		nil,
+		"table", table,
+	)
+	return tables, err
+}
+
+func (s *PostgresStreamStore) listStreamTablesTx(ctx context.Context, tx pgx.Tx, table string) ([]string, error) {
+	rows, _ := tx.Query(
+		ctx,
+		`SELECT c.relname FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relkind = 'r' AND c.relname LIKE $2
+		ORDER BY c.relname`,
+		s.schemaName,
+		table+`\_%`,
+	)
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// GetStreamsStorageUsage returns the number of rows and bytes stored for each stream.
+// Bytes are counted as stored in the database, miniblocks offloaded to cold storage are not included.
+func (s *PostgresStreamStore) GetStreamsStorageUsage(ctx context.Context) ([]*StreamStorageUsage, error) {
+	var ret []*StreamStorageUsage
+	err := s.replicaTxRunner(
+		ctx,
+		"GetStreamsStorageUsage",
+		func(ctx context.Context, tx pgx.Tx, _ bool) error {
+			var err error
+			ret, err = s.getStreamsStorageUsageTx(ctx, tx)
+			return err
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *PostgresStreamStore) getStreamsStorageUsageTx(ctx context.Context, tx pgx.Tx) ([]*StreamStorageUsage, error) {
+	var ret []*StreamStorageUsage
+	usage := make(map[StreamId]*StreamStorageUsage)
+
+	rows, _ := tx.Query(ctx, "SELECT stream_id FROM es")
+	var streamId StreamId
+	_, err := pgx.ForEachRow(rows, []any{&streamId}, func() error {
+		u := &StreamStorageUsage{StreamId: streamId}
+		usage[streamId] = u
+		ret = append(ret, u)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range []struct {
+		table  string
+		column string
+		where  string
+		add    func(u *StreamStorageUsage, count int64, size int64)
+	}{
+		{"miniblocks", "blockdata", "", func(u *StreamStorageUsage, count int64, size int64) {
+			u.Miniblocks += count
+			u.MiniblocksBytes += size
+		}},
+		// Generation marker is stored with slot -1.
+		{"minipools", "envelope", "WHERE slot_num >= 0", func(u *StreamStorageUsage, count int64, size int64) {
+			u.MinipoolEvents += count
+			u.MinipoolBytes += size
+		}},
+		{"miniblock_candidates", "blockdata", "", func(u *StreamStorageUsage, count int64, size int64) {
+			u.Candidates += count
+			u.CandidatesBytes += size
+		}},
+	} {
+		tables, err := s.listStreamTablesTx(ctx, tx, t.table)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, table := range tables {
+			rows, _ := tx.Query(
+				ctx,
+				fmt.Sprintf(
+					"SELECT stream_id, COUNT(*), COALESCE(SUM(octet_length(%s)), 0) FROM %s %s GROUP BY stream_id",
+					t.column,
+					pgx.Identifier{table}.Sanitize(),
+					t.where,
+				),
+			)
+			var count, size int64
+			_, err := pgx.ForEachRow(rows, []any{&streamId, &count, &size}, func() error {
+				if u, ok := usage[streamId]; ok {
+					t.add(u, count, size)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ret, nil
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"slices"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/crypto/sha3"
 
 	. "github.com/river-build/river/core/node/base"
@@ -33,7 +35,22 @@ type PostgresStreamStore struct {
 	nodeUUID          string
 	cleanupListenFunc func()
 
+	// readOnly is set if the store is opened by NewReadOnlyPostgresStreamStore.
+	readOnly bool
+
 	numPartitions int
+
+	codec                  *blobCodec
+	recompressedMiniblocks prometheus.Counter
+
+	// objectStore keeps offloaded miniblocks, nil if cold storage is disabled.
+	objectStore         ObjectStore
+	offloadedMiniblocks prometheus.Counter
+	coldReads           prometheus.Counter
+
+	prunedMiniblocks prometheus.Counter
+
+	collectedCandidates *prometheus.CounterVec
 }
 
 var _ StreamStorage = (*PostgresStreamStore)(nil)
@@ -114,9 +131,9 @@ func NewPostgresStreamStore(
 	exitSignal chan error,
 	metrics infra.MetricsFactory,
 ) (*PostgresStreamStore, error) {
-	store := &PostgresStreamStore{
-		nodeUUID:   instanceId,
-		exitSignal: exitSignal,
+	store, err := newPostgresStreamStore(poolInfo, instanceId, exitSignal, metrics)
+	if err != nil {
+		return nil, AsRiverError(err).Func("NewPostgresStreamStore")
 	}
 
 	if err := store.PostgresEventStore.init(
@@ -137,9 +154,111 @@ func NewPostgresStreamStore(
 	store.cleanupListenFunc = cancel
 	go store.listenForNewNodes(cancelCtx)
 
+	if store.codec.enabled() && poolInfo.Config.Compression.RecompressionInterval > 0 {
+		go store.runRecompression(cancelCtx, poolInfo.Config.Compression.RecompressionInterval)
+	}
+
+	if store.objectStore != nil && poolInfo.Config.ColdStorage.OffloadInterval > 0 {
+		go store.runOffload(cancelCtx, poolInfo.Config.ColdStorage.OffloadInterval)
+	}
+
+	if store.replicas != nil {
+		go store.replicas.runLagMonitor(cancelCtx)
+	}
+
+	if poolInfo.Config.CandidateGC.Interval > 0 {
+		go store.runCandidateGC(cancelCtx, poolInfo.Config.CandidateGC.Interval)
+	}
+
+	return store, nil
+}
+
+// NewReadOnlyPostgresStreamStore opens existing stream storage for tools that only read it,
+// such as storage verification, while the node keeps using it.
+// The store doesn't take over the storage from the node, doesn't run migrations and doesn't start background jobs.
+// All transactions are read-only and run on the primary, stream records are not locked.
+func NewReadOnlyPostgresStreamStore(
+	ctx context.Context,
+	poolInfo *PgxPoolInfo,
+	metrics infra.MetricsFactory,
+) (*PostgresStreamStore, error) {
+	store, err := newPostgresStreamStore(poolInfo, "read-only", nil, metrics)
+	if err != nil {
+		return nil, AsRiverError(err).Func("NewReadOnlyPostgresStreamStore")
+	}
+	store.readOnly = true
+	store.cleanupListenFunc = func() {}
+
+	store.PostgresEventStore.setup(ctx, poolInfo, metrics)
+	// Read replicas are not used, they are only picked after lag checks of the lag monitor.
+	store.replicas.close()
+	store.replicas = nil
+
+	err = store.txRunner(
+		ctx,
+		"readSettings",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return tx.QueryRow(
+				ctx,
+				"SELECT num_partitions FROM settings WHERE single_row_key = true",
+			).Scan(&store.numPartitions)
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, AsRiverError(err).Func("NewReadOnlyPostgresStreamStore").Message("Failed to read storage settings")
+	}
+
 	return store, nil
 }
 
+// newPostgresStreamStore creates the store without connecting to the database.
+func newPostgresStreamStore(
+	poolInfo *PgxPoolInfo,
+	instanceId string,
+	exitSignal chan error,
+	metrics infra.MetricsFactory,
+) (*PostgresStreamStore, error) {
+	codec, err := newBlobCodec(&poolInfo.Config.Compression, metrics)
+	if err != nil {
+		return nil, err
+	}
+
+	objectStore, err := NewObjectStore(&poolInfo.Config.ColdStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgresStreamStore{
+		nodeUUID:    instanceId,
+		exitSignal:  exitSignal,
+		codec:       codec,
+		objectStore: objectStore,
+		recompressedMiniblocks: metrics.NewCounterEx(
+			"storage_recompressed_miniblocks",
+			"Number of stored miniblocks rewritten by the background recompression job",
+		),
+		offloadedMiniblocks: metrics.NewCounterEx(
+			"storage_offloaded_miniblocks",
+			"Number of miniblocks moved to cold storage",
+		),
+		coldReads: metrics.NewCounterEx(
+			"storage_cold_reads",
+			"Number of miniblock ranges fetched from cold storage",
+		),
+		prunedMiniblocks: metrics.NewCounterEx(
+			"storage_pruned_miniblocks",
+			"Number of miniblocks removed by the stream retention policy",
+		),
+		collectedCandidates: metrics.NewCounterVecEx(
+			"storage_collected_miniblock_candidates",
+			"Number of stale miniblock candidates removed by the background collection",
+			"reason",
+		),
+	}, nil
+}
+
 func (s *PostgresStreamStore) initStreamStorage(ctx context.Context) error {
 	err := s.txRunner(
 		ctx,
@@ -172,6 +291,10 @@ func (s *PostgresStreamStore) txRunner(
 	tags ...any,
 ) error {
 	tags = append(tags, "currentUUID", s.nodeUUID)
+	if s.readOnly {
+		// Postgres rejects writes in read-only transactions.
+		accessMode = pgx.ReadOnly
+	}
 	return s.PostgresEventStore.txRunner(
 		ctx,
 		name,
@@ -197,8 +320,11 @@ func (s *PostgresStreamStore) txRunnerWithUUIDCheck(
 		name,
 		accessMode,
 		func(ctx context.Context, txn pgx.Tx) error {
-			if err := s.compareUUID(ctx, txn); err != nil {
-				return err
+			// Read-only store doesn't own the storage.
+			if !s.readOnly {
+				if err := s.compareUUID(ctx, txn); err != nil {
+					return err
+				}
 			}
 			return txFn(ctx, txn)
 		},
@@ -207,9 +333,9 @@ func (s *PostgresStreamStore) txRunnerWithUUIDCheck(
 	)
 }
 
-// createPartitionSuffix determines the partition mapping for a particular stream id the
+// CreatePartitionSuffix determines the partition mapping for a particular stream id the
 // hex encoding of the first byte of the xxHash of the stream ID.
-func createPartitionSuffix(streamId StreamId, numPartitions int) string {
+func CreatePartitionSuffix(streamId StreamId, numPartitions int) string {
 	// Media streams have separate partitions to handle the different data shapes and access
 	// patterns. The partition suffix is prefixed with an "m". Regular streams are assigned to
 	// partitions prefixed with "r", e.g. "miniblocks_ra4".
@@ -226,12 +352,24 @@ func createPartitionSuffix(streamId StreamId, numPartitions int) string {
 	return fmt.Sprintf("%s%02x", streamType, bt)
 }
 
+// streamLayout describes where the rows of a stream are stored. Legacy streams have dedicated
+// per-stream tables, migrated streams are hashed into one of numPartitions fixed partitions.
+// Each stream records the partition count it was stored with in es.num_partitions, so streams
+// can be moved to a new partition layout one by one while the node keeps serving them.
+type streamLayout struct {
+	migrated      bool
+	numPartitions int
+	// firstMiniblock is the first miniblock retained in storage, it's read together with the layout
+	// from the same stream record.
+	firstMiniblock int64
+}
+
 // sqlForStream escapes references to partitioned tables to the specific partition where the stream
 // is assigned whenever they are surrounded by double curly brackets.
-func (s *PostgresStreamStore) sqlForStream(sql string, streamId StreamId, migrated bool) string {
+func (s *PostgresStreamStore) sqlForStream(sql string, streamId StreamId, layout streamLayout) string {
 	var suffix string
-	if migrated {
-		suffix = createPartitionSuffix(streamId, s.numPartitions)
+	if layout.migrated {
+		suffix = CreatePartitionSuffix(streamId, layout.numPartitions)
 	} else {
 		suffix = createTableSuffix(streamId)
 	}
@@ -279,31 +417,60 @@ func (s *PostgresStreamStore) lockStream(
 	write bool,
 ) (
 	lastSnapshotMiniblock int64,
-	migrated bool,
+	layout streamLayout,
 	err error,
 ) {
+	if s.readOnly {
+		if write {
+			return 0, streamLayout{}, RiverError(Err_FAILED_PRECONDITION, "Storage is opened read-only").
+				Func("lockStream")
+		}
+		// Row locks are not allowed in read-only transactions.
+		return s.readStreamLayoutNoLock(ctx, tx, streamId)
+	}
+
 	if write {
 		err = tx.QueryRow(
 			ctx,
-			"SELECT latest_snapshot_miniblock, migrated from es WHERE stream_id = $1 FOR UPDATE",
+			"SELECT latest_snapshot_miniblock, migrated, num_partitions, first_miniblock from es WHERE stream_id = $1 FOR UPDATE",
 			streamId,
-		).Scan(&lastSnapshotMiniblock, &migrated)
+		).Scan(&lastSnapshotMiniblock, &layout.migrated, &layout.numPartitions, &layout.firstMiniblock)
 	} else {
 		err = tx.QueryRow(
 			ctx,
-			"SELECT latest_snapshot_miniblock, migrated from es WHERE stream_id = $1 FOR SHARE",
+			"SELECT latest_snapshot_miniblock, migrated, num_partitions, first_miniblock from es WHERE stream_id = $1 FOR SHARE",
 			streamId,
-		).Scan(&lastSnapshotMiniblock, &migrated)
+		).Scan(&lastSnapshotMiniblock, &layout.migrated, &layout.numPartitions, &layout.firstMiniblock)
 	}
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return 0, false, RiverError(Err_NOT_FOUND, "Stream not found", "streamId", streamId)
+			return 0, streamLayout{}, RiverError(Err_NOT_FOUND, "Stream not found", "streamId", streamId)
 		}
-		return 0, false, err
+		return 0, streamLayout{}, err
 	}
 
-	return lastSnapshotMiniblock, migrated, nil
+	return lastSnapshotMiniblock, layout, nil
+}
+
+// newStreamLayoutTx returns the layout for a stream created in this transaction. The partition
+// count is read from the settings table instead of using the count loaded at startup, since it
+// is changed by repartitioning while the node is running. The settings row is share-locked, so
+// repartitioning can't switch the count until the transaction creating the stream is committed.
+func (s *PostgresStreamStore) newStreamLayoutTx(ctx context.Context, tx pgx.Tx) (streamLayout, error) {
+	if !s.config.MigrateStreamCreation {
+		return streamLayout{}, nil
+	}
+
+	layout := streamLayout{migrated: true}
+	err := tx.QueryRow(
+		ctx,
+		"SELECT num_partitions FROM settings WHERE single_row_key = true FOR SHARE",
+	).Scan(&layout.numPartitions)
+	if err != nil {
+		return streamLayout{}, err
+	}
+	return layout, nil
 }
 
 func (s *PostgresStreamStore) createStreamStorageTx(
@@ -312,31 +479,44 @@ func (s *PostgresStreamStore) createStreamStorageTx(
 	streamId StreamId,
 	genesisMiniblock []byte,
 ) error {
+	layout, err := s.newStreamLayoutTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+
 	var sql string
-	if s.config.MigrateStreamCreation {
+	if layout.migrated {
 		sql = s.sqlForStream(
 			`
-			INSERT INTO es (stream_id, latest_snapshot_miniblock, migrated) VALUES ($1, 0, true);
+			INSERT INTO es (stream_id, latest_snapshot_miniblock, migrated, num_partitions) VALUES ($1, 0, true, $3);
 			INSERT INTO {{miniblocks}} (stream_id, seq_num, blockdata) VALUES ($1, 0, $2);
-			INSERT INTO {{minipools}} (stream_id, generation, slot_num) VALUES ($1, 1, -1);`,
+			INSERT INTO {{minipools}} (stream_id, generation, slot_num) VALUES ($1, 1, -1);
+			INSERT INTO stream_snapshots (stream_id, seq_num) VALUES ($1, 0);`,
 			streamId,
-			true,
+			layout,
 		)
 	} else {
 		sql = s.sqlForStream(
 			`
-			INSERT INTO es (stream_id, latest_snapshot_miniblock, migrated) VALUES ($1, 0, false);
+			INSERT INTO es (stream_id, latest_snapshot_miniblock, migrated, num_partitions) VALUES ($1, 0, false, $3);
 
 			CREATE TABLE {{miniblocks}} PARTITION OF miniblocks FOR VALUES IN ($1);
 			CREATE TABLE {{minipools}} PARTITION OF minipools FOR VALUES IN ($1);
 			CREATE TABLE {{miniblock_candidates}} PARTITION OF miniblock_candidates for values in ($1);
 			INSERT INTO {{miniblocks}} (stream_id, seq_num, blockdata) VALUES ($1, 0, $2);
-			INSERT INTO {{minipools}} (stream_id, generation, slot_num) VALUES ($1, 1, -1);`,
+			INSERT INTO {{minipools}} (stream_id, generation, slot_num) VALUES ($1, 1, -1);
+			INSERT INTO stream_snapshots (stream_id, seq_num) VALUES ($1, 0);`,
 			streamId,
-			false,
+			layout,
 		)
 	}
-	_, err := tx.Exec(ctx, sql, streamId, genesisMiniblock)
+	_, err = tx.Exec(
+		ctx,
+		sql,
+		streamId,
+		s.codec.encode(blobKindMiniblock, streamId, genesisMiniblock),
+		layout.numPartitions,
+	)
 	if err != nil {
 		if pgerr, ok := err.(*pgconn.PgError); ok && pgerr.Code == pgerrcode.UniqueViolation {
 			return WrapRiverError(Err_ALREADY_EXISTS, err).Message("stream already exists")
@@ -367,18 +547,23 @@ func (s *PostgresStreamStore) createStreamArchiveStorageTx(
 	tx pgx.Tx,
 	streamId StreamId,
 ) error {
+	layout, err := s.newStreamLayoutTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+
 	var sql string
-	if s.config.MigrateStreamCreation {
-		sql = `INSERT INTO es (stream_id, latest_snapshot_miniblock, migrated) VALUES ($1, -1, true);`
+	if layout.migrated {
+		sql = `INSERT INTO es (stream_id, latest_snapshot_miniblock, migrated, num_partitions) VALUES ($1, -1, true, $2);`
 	} else {
 		sql = s.sqlForStream(
-			`INSERT INTO es (stream_id, latest_snapshot_miniblock, migrated) VALUES ($1, -1, false);
+			`INSERT INTO es (stream_id, latest_snapshot_miniblock, migrated, num_partitions) VALUES ($1, -1, false, $2);
 			CREATE TABLE {{miniblocks}} PARTITION OF miniblocks FOR VALUES IN ($1);`,
 			streamId,
-			false,
+			layout,
 		)
 	}
-	_, err := tx.Exec(ctx, sql, streamId)
+	_, err = tx.Exec(ctx, sql, streamId, layout.numPartitions)
 	if err != nil {
 		if pgerr, ok := err.(*pgconn.PgError); ok && pgerr.Code == pgerrcode.UniqueViolation {
 			return WrapRiverError(Err_ALREADY_EXISTS, err).Message("stream already exists")
@@ -415,7 +600,7 @@ func (s *PostgresStreamStore) getMaxArchivedMiniblockNumberTx(
 	streamId StreamId,
 	maxArchivedMiniblockNumber *int64,
 ) error {
-	_, migrated, err := s.lockStream(ctx, tx, streamId, false)
+	_, layout, err := s.lockStream(ctx, tx, streamId, false)
 	if err != nil {
 		return err
 	}
@@ -425,7 +610,7 @@ func (s *PostgresStreamStore) getMaxArchivedMiniblockNumberTx(
 		s.sqlForStream(
 			"SELECT COALESCE(MAX(seq_num), -1) FROM {{miniblocks}} WHERE stream_id = $1",
 			streamId,
-			migrated,
+			layout,
 		),
 		streamId,
 	).Scan(maxArchivedMiniblockNumber)
@@ -477,7 +662,7 @@ func (s *PostgresStreamStore) writeArchiveMiniblocksTx(
 	startMiniblockNum int64,
 	miniblocks [][]byte,
 ) error {
-	_, migrated, err := s.lockStream(ctx, tx, streamId, true)
+	_, layout, err := s.lockStream(ctx, tx, streamId, true)
 	if err != nil {
 		return err
 	}
@@ -503,11 +688,11 @@ func (s *PostgresStreamStore) writeArchiveMiniblocksTx(
 			s.sqlForStream(
 				"INSERT INTO {{miniblocks}} (stream_id, seq_num, blockdata) VALUES ($1, $2, $3)",
 				streamId,
-				migrated,
+				layout,
 			),
 			streamId,
 			startMiniblockNum+int64(i),
-			miniblock)
+			s.codec.encode(blobKindMiniblock, streamId, miniblock))
 		if err != nil {
 			return err
 		}
@@ -545,7 +730,7 @@ func (s *PostgresStreamStore) readStreamFromLastSnapshotTx(
 	streamId StreamId,
 	numToRead int,
 ) (*ReadStreamFromLastSnapshotResult, error) {
-	snapshotMiniblockIndex, migrated, err := s.lockStream(ctx, tx, streamId, false)
+	snapshotMiniblockIndex, layout, err := s.lockStream(ctx, tx, streamId, false)
 	if err != nil {
 		return nil, err
 	}
@@ -557,7 +742,7 @@ func (s *PostgresStreamStore) readStreamFromLastSnapshotTx(
 			s.sqlForStream(
 				"SELECT MAX(seq_num) FROM {{miniblocks}} WHERE stream_id = $1",
 				streamId,
-				migrated,
+				layout,
 			),
 			streamId).
 		Scan(&lastMiniblockIndex)
@@ -565,16 +750,14 @@ func (s *PostgresStreamStore) readStreamFromLastSnapshotTx(
 		return nil, WrapRiverError(Err_INTERNAL, err).Message("db inconsistency: failed to get last miniblock index")
 	}
 
-	numToRead = max(1, numToRead)
-	startSeqNum := max(0, lastMiniblockIndex-int64(numToRead-1))
-	startSeqNum = min(startSeqNum, snapshotMiniblockIndex)
+	startSeqNum := lastSnapshotReadStart(lastMiniblockIndex, snapshotMiniblockIndex, numToRead)
 
 	miniblocksRow, err := tx.Query(
 		ctx,
 		s.sqlForStream(
 			"SELECT blockdata, seq_num FROM {{miniblocks}} WHERE seq_num >= $1 AND stream_id = $2 ORDER BY seq_num",
 			streamId,
-			migrated,
+			layout,
 		),
 		startSeqNum,
 		streamId,
@@ -584,29 +767,91 @@ func (s *PostgresStreamStore) readStreamFromLastSnapshotTx(
 	}
 	defer miniblocksRow.Close()
 
-	var miniblocks [][]byte
-	var counter int64 = 0
-	var readLastSeqNum int64
-	var readFirstSeqNum int64
+	var miniblocks []lastSnapshotMiniblockRow
 	for miniblocksRow.Next() {
-		var blockdata []byte
-		err = miniblocksRow.Scan(&blockdata, &readLastSeqNum)
+		var row lastSnapshotMiniblockRow
+		err = miniblocksRow.Scan(&row.blockdata, &row.seqNum)
+		if err != nil {
+			return nil, err
+		}
+		miniblocks = append(miniblocks, row)
+	}
+	miniblocksRow.Close()
+
+	rows, err := tx.Query(
+		ctx,
+		s.sqlForStream(
+			"SELECT envelope, generation, slot_num FROM {{minipools}} WHERE stream_id = $1 ORDER BY generation, slot_num",
+			streamId,
+			layout,
+		),
+		streamId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var minipool []lastSnapshotMinipoolRow
+	for rows.Next() {
+		var row lastSnapshotMinipoolRow
+		err = rows.Scan(&row.envelope, &row.generation, &row.slotNum)
 		if err != nil {
 			return nil, err
 		}
+		minipool = append(minipool, row)
+	}
+	rows.Close()
+
+	return s.makeReadStreamFromLastSnapshotResult(snapshotMiniblockIndex, miniblocks, minipool)
+}
+
+type lastSnapshotMiniblockRow struct {
+	seqNum    int64
+	blockdata []byte
+}
+
+type lastSnapshotMinipoolRow struct {
+	generation int64
+	slotNum    int64
+	envelope   []byte
+}
+
+// lastSnapshotReadStart returns the number of the first miniblock to read so that
+// at least numToRead last miniblocks and the last snapshot miniblock are returned.
+func lastSnapshotReadStart(lastMiniblockIndex int64, snapshotMiniblockIndex int64, numToRead int) int64 {
+	numToRead = max(1, numToRead)
+	startSeqNum := max(0, lastMiniblockIndex-int64(numToRead-1))
+	return min(startSeqNum, snapshotMiniblockIndex)
+}
+
+// makeReadStreamFromLastSnapshotResult checks that miniblock and minipool rows of a stream,
+// sorted by seq_num and generation, slot_num respectively, are consistent and decodes them.
+func (s *PostgresStreamStore) makeReadStreamFromLastSnapshotResult(
+	snapshotMiniblockIndex int64,
+	miniblockRows []lastSnapshotMiniblockRow,
+	minipoolRows []lastSnapshotMinipoolRow,
+) (*ReadStreamFromLastSnapshotResult, error) {
+	var miniblocks [][]byte
+	var readLastSeqNum int64
+	var readFirstSeqNum int64
+	for counter, row := range miniblockRows {
+		readLastSeqNum = row.seqNum
 		if counter == 0 {
 			readFirstSeqNum = readLastSeqNum
-		} else if readLastSeqNum != readFirstSeqNum+counter {
+		} else if readLastSeqNum != readFirstSeqNum+int64(counter) {
 			return nil, RiverError(
 				Err_INTERNAL,
 				"Miniblocks consistency violation - miniblocks are not sequential in db",
 				"ActualSeqNum", readLastSeqNum,
-				"ExpectedSeqNum", readFirstSeqNum+counter)
+				"ExpectedSeqNum", readFirstSeqNum+int64(counter))
+		}
+		blockdata, err := s.codec.decode(row.blockdata)
+		if err != nil {
+			return nil, err
 		}
 		miniblocks = append(miniblocks, blockdata)
-		counter++
 	}
-	miniblocksRow.Close()
 
 	if !(readFirstSeqNum <= snapshotMiniblockIndex && snapshotMiniblockIndex <= readLastSeqNum) {
 		return nil, RiverError(
@@ -617,49 +862,32 @@ func (s *PostgresStreamStore) readStreamFromLastSnapshotTx(
 			"readLastSeqNum", readLastSeqNum)
 	}
 
-	rows, err := tx.Query(
-		ctx,
-		s.sqlForStream(
-			"SELECT envelope, generation, slot_num FROM {{minipools}} WHERE stream_id = $1 ORDER BY generation, slot_num",
-			streamId,
-			migrated,
-		),
-		streamId,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
 	var envelopes [][]byte
 	var expectedGeneration int64 = readLastSeqNum + 1
 	var expectedSlot int64 = -1
-	for rows.Next() {
-		var envelope []byte
-		var generation int64
-		var slotNum int64
-		err = rows.Scan(&envelope, &generation, &slotNum)
-		if err != nil {
-			return nil, err
-		}
-		if generation != expectedGeneration {
+	for _, row := range minipoolRows {
+		if row.generation != expectedGeneration {
 			return nil, RiverError(
 				Err_MINIBLOCKS_STORAGE_FAILURE,
 				"Minipool consistency violation - minipool generation doesn't match last miniblock generation",
 			).
-				Tag("generation", generation).
+				Tag("generation", row.generation).
 				Tag("expectedGeneration", expectedGeneration)
 		}
-		if slotNum != expectedSlot {
+		if row.slotNum != expectedSlot {
 			return nil, RiverError(
 				Err_MINIBLOCKS_STORAGE_FAILURE,
 				"Minipool consistency violation - slotNums are not sequential",
 			).
-				Tag("slotNum", slotNum).
+				Tag("slotNum", row.slotNum).
 				Tag("expectedSlot", expectedSlot)
 		}
 
-		if slotNum >= 0 {
+		if row.slotNum >= 0 {
+			envelope, err := s.codec.decode(row.envelope)
+			if err != nil {
+				return nil, err
+			}
 			envelopes = append(envelopes, envelope)
 		}
 		expectedSlot++
@@ -673,6 +901,215 @@ func (s *PostgresStreamStore) readStreamFromLastSnapshotTx(
 	}, nil
 }
 
+func (s *PostgresStreamStore) ReadStreamsFromLastSnapshot(
+	ctx context.Context,
+	streamIds []StreamId,
+	numToRead int,
+) (map[StreamId]*ReadStreamFromLastSnapshotResult, error) {
+	var ret map[StreamId]*ReadStreamFromLastSnapshotResult
+	err := s.txRunnerWithUUIDCheck(
+		ctx,
+		"ReadStreamsFromLastSnapshot",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			ret, err = s.readStreamsFromLastSnapshotTx(ctx, tx, streamIds, numToRead)
+			return err
+		},
+		nil,
+		"numStreams", len(streamIds),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// lastSnapshotReadGroup is a set of streams stored in the same partition tables.
+type lastSnapshotReadGroup struct {
+	streamId  StreamId // any stream of the group, used to resolve table names
+	layout    streamLayout
+	streamIds []string
+}
+
+// readStreamsFromLastSnapshotTx share-locks all requested streams with a single query,
+// groups them by partition and reads miniblocks and minipools of each group with
+// one query per table.
+func (s *PostgresStreamStore) readStreamsFromLastSnapshotTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamIds []StreamId,
+	numToRead int,
+) (map[StreamId]*ReadStreamFromLastSnapshotResult, error) {
+	ids := make([]string, len(streamIds))
+	for i, streamId := range streamIds {
+		ids[i] = streamId.String()
+	}
+
+	sql := `SELECT stream_id, latest_snapshot_miniblock, migrated, num_partitions FROM es
+		WHERE stream_id = ANY($1) ORDER BY stream_id`
+	if !s.readOnly {
+		sql += " FOR SHARE"
+	}
+	rows, err := tx.Query(ctx, sql, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	snapshots := make(map[string]int64, len(streamIds))
+	groups := make(map[string]*lastSnapshotReadGroup)
+	for rows.Next() {
+		var id string
+		var snapshotMiniblockIndex int64
+		var layout streamLayout
+		err = rows.Scan(&id, &snapshotMiniblockIndex, &layout.migrated, &layout.numPartitions)
+		if err != nil {
+			return nil, err
+		}
+		streamId, err := StreamIdFromString(id)
+		if err != nil {
+			return nil, err
+		}
+		snapshots[id] = snapshotMiniblockIndex
+
+		table := s.sqlForStream("{{miniblocks}}", streamId, layout)
+		group, ok := groups[table]
+		if !ok {
+			group = &lastSnapshotReadGroup{streamId: streamId, layout: layout}
+			groups[table] = group
+		}
+		group.streamIds = append(group.streamIds, id)
+	}
+	rows.Close()
+
+	ret := make(map[StreamId]*ReadStreamFromLastSnapshotResult, len(snapshots))
+	for _, group := range groups {
+		err = s.readStreamGroupFromLastSnapshotTx(ctx, tx, group, snapshots, numToRead, ret)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func (s *PostgresStreamStore) readStreamGroupFromLastSnapshotTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	group *lastSnapshotReadGroup,
+	snapshots map[string]int64,
+	numToRead int,
+	ret map[StreamId]*ReadStreamFromLastSnapshotResult,
+) error {
+	rows, err := tx.Query(
+		ctx,
+		s.sqlForStream(
+			"SELECT stream_id, MAX(seq_num) FROM {{miniblocks}} WHERE stream_id = ANY($1) GROUP BY stream_id",
+			group.streamId,
+			group.layout,
+		),
+		group.streamIds,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	startSeqNums := make(map[string]int64, len(group.streamIds))
+	for rows.Next() {
+		var id string
+		var lastMiniblockIndex int64
+		err = rows.Scan(&id, &lastMiniblockIndex)
+		if err != nil {
+			return err
+		}
+		startSeqNums[id] = lastSnapshotReadStart(lastMiniblockIndex, snapshots[id], numToRead)
+	}
+	rows.Close()
+
+	if len(startSeqNums) != len(group.streamIds) {
+		return RiverError(Err_INTERNAL, "db inconsistency: failed to get last miniblock index").
+			Tag("numStreams", len(group.streamIds)).
+			Tag("numWithMiniblocks", len(startSeqNums))
+	}
+
+	ids := make([]string, 0, len(startSeqNums))
+	starts := make([]int64, 0, len(startSeqNums))
+	for id, start := range startSeqNums {
+		ids = append(ids, id)
+		starts = append(starts, start)
+	}
+
+	rows, err = tx.Query(
+		ctx,
+		s.sqlForStream(
+			`SELECT m.stream_id, m.blockdata, m.seq_num FROM {{miniblocks}} m
+			JOIN unnest($1::CHAR(64)[], $2::BIGINT[]) AS r(stream_id, start_seq_num) ON m.stream_id = r.stream_id
+			WHERE m.seq_num >= r.start_seq_num ORDER BY m.stream_id, m.seq_num`,
+			group.streamId,
+			group.layout,
+		),
+		ids,
+		starts,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	miniblocks := make(map[string][]lastSnapshotMiniblockRow, len(ids))
+	for rows.Next() {
+		var id string
+		var row lastSnapshotMiniblockRow
+		err = rows.Scan(&id, &row.blockdata, &row.seqNum)
+		if err != nil {
+			return err
+		}
+		miniblocks[id] = append(miniblocks[id], row)
+	}
+	rows.Close()
+
+	rows, err = tx.Query(
+		ctx,
+		s.sqlForStream(
+			`SELECT stream_id, envelope, generation, slot_num FROM {{minipools}}
+			WHERE stream_id = ANY($1) ORDER BY stream_id, generation, slot_num`,
+			group.streamId,
+			group.layout,
+		),
+		group.streamIds,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	minipools := make(map[string][]lastSnapshotMinipoolRow, len(ids))
+	for rows.Next() {
+		var id string
+		var row lastSnapshotMinipoolRow
+		err = rows.Scan(&id, &row.envelope, &row.generation, &row.slotNum)
+		if err != nil {
+			return err
+		}
+		minipools[id] = append(minipools[id], row)
+	}
+	rows.Close()
+
+	for _, id := range group.streamIds {
+		streamId, err := StreamIdFromString(id)
+		if err != nil {
+			return err
+		}
+		result, err := s.makeReadStreamFromLastSnapshotResult(snapshots[id], miniblocks[id], minipools[id])
+		if err != nil {
+			return AsRiverError(err).Tag("streamId", streamId)
+		}
+		ret[streamId] = result
+	}
+	return nil
+}
+
 // Adds event to the given minipool.
 // Current generation of minipool should match minipoolGeneration,
 // and there should be exactly minipoolSlot events in the minipool.
@@ -709,7 +1146,7 @@ func (s *PostgresStreamStore) writeEventTx(
 	minipoolSlot int,
 	envelope []byte,
 ) error {
-	_, migrated, err := s.lockStream(ctx, tx, streamId, true)
+	_, layout, err := s.lockStream(ctx, tx, streamId, true)
 	if err != nil {
 		return err
 	}
@@ -720,7 +1157,7 @@ func (s *PostgresStreamStore) writeEventTx(
 		s.sqlForStream(
 			"SELECT generation, slot_num FROM {{minipools}} WHERE stream_id = $1 ORDER BY generation, slot_num",
 			streamId,
-			migrated,
+			layout,
 		),
 		streamId,
 	)
@@ -764,10 +1201,10 @@ func (s *PostgresStreamStore) writeEventTx(
 		s.sqlForStream(
 			"INSERT INTO {{minipools}} (stream_id, envelope, generation, slot_num) VALUES ($1, $2, $3, $4)",
 			streamId,
-			migrated,
+			layout,
 		),
 		streamId,
-		envelope,
+		s.codec.encode(blobKindEnvelope, streamId, envelope),
 		minipoolGeneration,
 		minipoolSlot,
 	)
@@ -789,14 +1226,28 @@ func (s *PostgresStreamStore) ReadMiniblocks(
 	toExclusive int64,
 ) ([][]byte, error) {
 	var miniblocks [][]byte
-	err := s.txRunnerWithUUIDCheck(
+	var offloaded []offloadedRange
+	err := s.replicaTxRunner(
 		ctx,
 		"ReadMiniblocks",
-		pgx.ReadWrite,
-		func(ctx context.Context, tx pgx.Tx) error {
+		func(ctx context.Context, tx pgx.Tx, replica bool) error {
 			var err error
-			miniblocks, err = s.readMiniblocksTx(ctx, tx, streamId, fromInclusive, toExclusive)
-			return err
+			miniblocks, err = s.readMiniblocksTx(ctx, tx, streamId, fromInclusive, toExclusive, replica)
+			if err != nil {
+				return err
+			}
+			offloaded, err = s.readOffloadedRangesTx(ctx, tx, streamId, fromInclusive, toExclusive)
+			if err != nil {
+				return err
+			}
+			// Replica may not have the latest miniblocks yet. Requests past the end of the stream
+			// are served by the primary as well, since the replica can't tell them apart.
+			if replica &&
+				int64(len(miniblocks))+offloadedCount(offloaded, fromInclusive, toExclusive) <
+					toExclusive-max(0, fromInclusive) {
+				return replicaBehindError()
+			}
+			return nil
 		},
 		nil,
 		"streamId", streamId,
@@ -804,6 +1255,15 @@ func (s *PostgresStreamStore) ReadMiniblocks(
 	if err != nil {
 		return nil, err
 	}
+
+	// Offloaded ranges always precede miniblocks stored in the database.
+	if len(offloaded) > 0 {
+		coldMiniblocks, err := s.readOffloadedMiniblocks(ctx, streamId, offloaded, fromInclusive, toExclusive)
+		if err != nil {
+			return nil, err
+		}
+		miniblocks = append(coldMiniblocks, miniblocks...)
+	}
 	return miniblocks, nil
 }
 
@@ -813,18 +1273,23 @@ func (s *PostgresStreamStore) readMiniblocksTx(
 	streamId StreamId,
 	fromInclusive int64,
 	toExclusive int64,
+	replica bool,
 ) ([][]byte, error) {
-	_, migrated, err := s.lockStream(ctx, tx, streamId, false)
+	_, layout, err := s.readStreamLayout(ctx, tx, streamId, replica)
 	if err != nil {
 		return nil, err
 	}
 
+	if max(0, fromInclusive) < layout.firstMiniblock {
+		return nil, miniblocksPrunedError(streamId, max(0, fromInclusive), layout.firstMiniblock)
+	}
+
 	miniblocksRow, err := tx.Query(
 		ctx,
 		s.sqlForStream(
 			"SELECT blockdata, seq_num FROM {{miniblocks}} WHERE seq_num >= $1 AND seq_num < $2 AND stream_id = $3 ORDER BY seq_num",
 			streamId,
-			migrated,
+			layout,
 		),
 		fromInclusive,
 		toExclusive,
@@ -855,6 +1320,10 @@ func (s *PostgresStreamStore) readMiniblocksTx(
 		}
 		prevSeqNum = seq_num
 
+		blockdata, err = s.codec.decode(blockdata)
+		if err != nil {
+			return nil, err
+		}
 		miniblocks = append(miniblocks, blockdata)
 	}
 	return miniblocks, nil
@@ -893,7 +1362,7 @@ func (s *PostgresStreamStore) writeBlockProposalTxn(
 	blockNumber int64,
 	miniblock []byte,
 ) error {
-	_, migrated, err := s.lockStream(ctx, tx, streamId, true)
+	_, layout, err := s.lockStream(ctx, tx, streamId, true)
 	if err != nil {
 		return err
 	}
@@ -904,7 +1373,7 @@ func (s *PostgresStreamStore) writeBlockProposalTxn(
 		s.sqlForStream(
 			"SELECT MAX(seq_num) as latest_blocks_number FROM {{miniblocks}} WHERE stream_id = $1",
 			streamId,
-			migrated,
+			layout,
 		),
 		streamId,
 	).Scan(&seqNum)
@@ -926,12 +1395,12 @@ func (s *PostgresStreamStore) writeBlockProposalTxn(
 		s.sqlForStream(
 			"INSERT INTO {{miniblock_candidates}} (stream_id, seq_num, block_hash, blockdata) VALUES ($1, $2, $3, $4)",
 			streamId,
-			migrated,
+			layout,
 		),
 		streamId,
 		blockNumber,
 		hex.EncodeToString(blockHash.Bytes()), // avoid leading '0x'
-		miniblock,
+		s.codec.encode(blobKindCandidate, streamId, miniblock),
 	)
 	if err != nil {
 		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == pgerrcode.UniqueViolation {
@@ -976,7 +1445,7 @@ func (s *PostgresStreamStore) readMiniblockCandidateTx(
 	blockHash common.Hash,
 	blockNumber int64,
 ) ([]byte, error) {
-	_, migrated, err := s.lockStream(ctx, tx, streamId, false)
+	_, layout, err := s.lockStream(ctx, tx, streamId, false)
 	if err != nil {
 		return nil, err
 	}
@@ -987,7 +1456,7 @@ func (s *PostgresStreamStore) readMiniblockCandidateTx(
 		s.sqlForStream(
 			"SELECT blockdata FROM {{miniblock_candidates}} WHERE stream_id = $1 AND seq_num = $2 AND block_hash = $3",
 			streamId,
-			migrated,
+			layout,
 		),
 		streamId,
 		blockNumber,
@@ -999,7 +1468,7 @@ func (s *PostgresStreamStore) readMiniblockCandidateTx(
 		}
 		return nil, err
 	}
-	return miniblock, nil
+	return s.codec.decode(miniblock)
 }
 
 func (s *PostgresStreamStore) WriteMiniblocks(
@@ -1071,7 +1540,7 @@ func (s *PostgresStreamStore) writeMiniblocksTx(
 	prevMinipoolGeneration int64,
 	prevMinipoolSize int,
 ) error {
-	_, migrated, err := s.lockStream(ctx, tx, streamId, true)
+	_, layout, err := s.lockStream(ctx, tx, streamId, true)
 	if err != nil {
 		return err
 	}
@@ -1083,7 +1552,7 @@ func (s *PostgresStreamStore) writeMiniblocksTx(
 		s.sqlForStream(
 			"SELECT MAX(seq_num) FROM {{miniblocks}} WHERE stream_id = $1",
 			streamId,
-			migrated,
+			layout,
 		),
 		streamId,
 	).Scan(&lastMbNumInStorage)
@@ -1116,7 +1585,7 @@ func (s *PostgresStreamStore) writeMiniblocksTx(
 		s.sqlForStream(
 			"DELETE FROM {{minipools}} WHERE stream_id = $1 RETURNING generation, slot_num",
 			streamId,
-			migrated,
+			layout,
 		),
 		streamId,
 	)
@@ -1175,7 +1644,7 @@ func (s *PostgresStreamStore) writeMiniblocksTx(
 		s.sqlForStream(
 			"INSERT INTO {{minipools}} (stream_id, generation, slot_num) VALUES ($1, $2, -1)",
 			streamId,
-			migrated,
+			layout,
 		),
 		streamId,
 		newMinipoolGeneration,
@@ -1185,12 +1654,17 @@ func (s *PostgresStreamStore) writeMiniblocksTx(
 	}
 	_, err = tx.CopyFrom(
 		ctx,
-		pgx.Identifier{s.sqlForStream("{{minipools}}", streamId, migrated)},
+		pgx.Identifier{s.sqlForStream("{{minipools}}", streamId, layout)},
 		[]string{"stream_id", "generation", "slot_num", "envelope"},
 		pgx.CopyFromSlice(
 			len(newMinipoolEnvelopes),
 			func(i int) ([]any, error) {
-				return []any{streamId, newMinipoolGeneration, i, newMinipoolEnvelopes[i]}, nil
+				return []any{
+					streamId,
+					newMinipoolGeneration,
+					i,
+					s.codec.encode(blobKindEnvelope, streamId, newMinipoolEnvelopes[i]),
+				}, nil
 			},
 		),
 	)
@@ -1200,17 +1674,23 @@ func (s *PostgresStreamStore) writeMiniblocksTx(
 
 	// Insert all miniblocks into miniblocks table.
 	newLastSnapshotMiniblock := int64(-1)
+	var snapshots []int64
 	_, err = tx.CopyFrom(
 		ctx,
-		pgx.Identifier{s.sqlForStream("{{miniblocks}}", streamId, migrated)},
+		pgx.Identifier{s.sqlForStream("{{miniblocks}}", streamId, layout)},
 		[]string{"stream_id", "seq_num", "blockdata"},
 		pgx.CopyFromSlice(
 			len(miniblocks),
 			func(i int) ([]any, error) {
 				if miniblocks[i].Snapshot {
 					newLastSnapshotMiniblock = miniblocks[i].Number
+					snapshots = append(snapshots, miniblocks[i].Number)
 				}
-				return []any{streamId, miniblocks[i].Number, miniblocks[i].Data}, nil
+				return []any{
+					streamId,
+					miniblocks[i].Number,
+					s.codec.encode(blobKindMiniblock, streamId, miniblocks[i].Data),
+				}, nil
 			},
 		),
 	)
@@ -1229,6 +1709,15 @@ func (s *PostgresStreamStore) writeMiniblocksTx(
 		if err != nil {
 			return err
 		}
+		_, err = tx.Exec(
+			ctx,
+			`INSERT INTO stream_snapshots (stream_id, seq_num) SELECT $1, unnest($2::BIGINT[])`,
+			streamId,
+			snapshots,
+		)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Delete miniblock candidates up to the last miniblock number.
@@ -1237,7 +1726,7 @@ func (s *PostgresStreamStore) writeMiniblocksTx(
 		s.sqlForStream(
 			"DELETE FROM {{miniblock_candidates}} WHERE stream_id = $1 and seq_num < $2",
 			streamId,
-			migrated,
+			layout,
 		),
 		streamId,
 		newMinipoolGeneration,
@@ -1311,16 +1800,19 @@ func (s *PostgresStreamStore) compareUUID(ctx context.Context, tx pgx.Tx) error
 // Close removes instance record from singlenodekey table, releases the listener connection, and
 // closes the postgres connection pool
 func (s *PostgresStreamStore) Close(ctx context.Context) {
-	err := s.CleanupStreamStorage(ctx)
-	if err != nil {
-		log := dlog.FromCtx(ctx)
-		log.Error("Error when deleting singlenodekey entry", "error", err)
+	if !s.readOnly {
+		err := s.CleanupStreamStorage(ctx)
+		if err != nil {
+			log := dlog.FromCtx(ctx)
+			log.Error("Error when deleting singlenodekey entry", "error", err)
+		}
 	}
 
 	// Cancel the notify listening func to release the listener connection before closing the pool.
 	s.cleanupListenFunc()
 
 	s.PostgresEventStore.Close(ctx)
+	s.codec.close()
 }
 
 func (s *PostgresStreamStore) CleanupStreamStorage(ctx context.Context) error {
@@ -1385,25 +1877,45 @@ func (s *PostgresStreamStore) getStreamsTx(ctx context.Context, tx pgx.Tx) ([]St
 }
 
 func (s *PostgresStreamStore) DeleteStream(ctx context.Context, streamId StreamId) error {
-	return s.txRunnerWithUUIDCheck(
+	var objectKeys []string
+	err := s.txRunnerWithUUIDCheck(
 		ctx,
 		"DeleteStream",
 		pgx.ReadWrite,
 		func(ctx context.Context, tx pgx.Tx) error {
-			return s.deleteStreamTx(ctx, tx, streamId)
+			var err error
+			objectKeys, err = s.deleteStreamTx(ctx, tx, streamId)
+			return err
 		},
 		nil,
 		"streamId", streamId,
 	)
+	if err != nil {
+		return err
+	}
+
+	// Objects are deleted after the transaction is committed, so index never references missing objects.
+	s.deleteOffloadedObjects(ctx, objectKeys)
+	return nil
 }
 
-func (s *PostgresStreamStore) deleteStreamTx(ctx context.Context, tx pgx.Tx, streamId StreamId) error {
-	_, migrated, err := s.lockStream(ctx, tx, streamId, true)
+func (s *PostgresStreamStore) deleteStreamTx(ctx context.Context, tx pgx.Tx, streamId StreamId) ([]string, error) {
+	_, layout, err := s.lockStream(ctx, tx, streamId, true)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	objectKeys, err := s.deleteOffloadedRangesTx(ctx, tx, streamId, math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, "DELETE FROM stream_snapshots WHERE stream_id = $1", streamId)
+	if err != nil {
+		return nil, err
 	}
 
-	if migrated {
+	if layout.migrated {
 		_, err = tx.Exec(
 			ctx,
 			s.sqlForStream(
@@ -1412,11 +1924,11 @@ func (s *PostgresStreamStore) deleteStreamTx(ctx context.Context, tx pgx.Tx, str
 				DELETE from {{miniblock_candidates}} where stream_id = $1;
 				DELETE FROM es WHERE stream_id = $1`,
 				streamId,
-				true,
+				layout,
 			),
 			streamId,
 		)
-		return err
+		return objectKeys, err
 	} else {
 		_, err = tx.Exec(
 			ctx,
@@ -1426,10 +1938,10 @@ func (s *PostgresStreamStore) deleteStreamTx(ctx context.Context, tx pgx.Tx, str
 				DROP TABLE {{miniblock_candidates}};
 				DELETE FROM es WHERE stream_id = $1`,
 				streamId,
-				false,
+				layout,
 			),
 			streamId)
-		return err
+		return objectKeys, err
 	}
 }
 
@@ -1584,13 +2096,12 @@ func (s *PostgresStreamStore) DebugReadStreamData(
 	streamId StreamId,
 ) (*DebugReadStreamDataResult, error) {
 	var ret *DebugReadStreamDataResult
-	err := s.txRunnerWithUUIDCheck(
+	err := s.replicaTxRunner(
 		ctx,
 		"DebugReadStreamData",
-		pgx.ReadWrite,
-		func(ctx context.Context, tx pgx.Tx) error {
+		func(ctx context.Context, tx pgx.Tx, replica bool) error {
 			var err error
-			ret, err = s.debugReadStreamData(ctx, tx, streamId)
+			ret, err = s.debugReadStreamData(ctx, tx, streamId, replica)
 			return err
 		},
 		nil,
@@ -1606,8 +2117,9 @@ func (s *PostgresStreamStore) debugReadStreamData(
 	ctx context.Context,
 	tx pgx.Tx,
 	streamId StreamId,
+	replica bool,
 ) (*DebugReadStreamDataResult, error) {
-	lastSnapshotMiniblock, migrated, err := s.lockStream(ctx, tx, streamId, false)
+	lastSnapshotMiniblock, layout, err := s.readStreamLayout(ctx, tx, streamId, replica)
 	if err != nil {
 		return nil, err
 	}
@@ -1615,7 +2127,7 @@ func (s *PostgresStreamStore) debugReadStreamData(
 	result := &DebugReadStreamDataResult{
 		StreamId:                   streamId,
 		LatestSnapshotMiniblockNum: lastSnapshotMiniblock,
-		Migrated:                   migrated,
+		Migrated:                   layout.migrated,
 	}
 
 	miniblocksRow, err := tx.Query(
@@ -1623,7 +2135,7 @@ func (s *PostgresStreamStore) debugReadStreamData(
 		s.sqlForStream(
 			"SELECT seq_num, blockdata FROM {{miniblocks}} WHERE stream_id = $1 ORDER BY seq_num",
 			streamId,
-			result.Migrated,
+			layout,
 		),
 		streamId,
 	)
@@ -1639,6 +2151,10 @@ func (s *PostgresStreamStore) debugReadStreamData(
 		if err != nil {
 			return nil, err
 		}
+		mb.Data, err = s.codec.decode(mb.Data)
+		if err != nil {
+			return nil, err
+		}
 		result.Miniblocks = append(result.Miniblocks, mb)
 	}
 
@@ -1647,7 +2163,7 @@ func (s *PostgresStreamStore) debugReadStreamData(
 		s.sqlForStream(
 			"SELECT generation, slot_num, envelope FROM {{minipools}} WHERE stream_id = $1 ORDER BY generation, slot_num",
 			streamId,
-			result.Migrated,
+			layout,
 		),
 		streamId,
 	)
@@ -1662,6 +2178,10 @@ func (s *PostgresStreamStore) debugReadStreamData(
 		if err != nil {
 			return nil, err
 		}
+		e.Data, err = s.codec.decode(e.Data)
+		if err != nil {
+			return nil, err
+		}
 		result.Events = append(result.Events, e)
 	}
 
@@ -1670,7 +2190,7 @@ func (s *PostgresStreamStore) debugReadStreamData(
 		s.sqlForStream(
 			"SELECT seq_num, block_hash, blockdata FROM {{miniblock_candidates}} WHERE stream_id = $1 ORDER BY seq_num",
 			streamId,
-			result.Migrated,
+			layout,
 		),
 		streamId,
 	)
@@ -1687,6 +2207,10 @@ func (s *PostgresStreamStore) debugReadStreamData(
 		if err != nil {
 			return nil, err
 		}
+		data, err = s.codec.decode(data)
+		if err != nil {
+			return nil, err
+		}
 		result.MbCandidates = append(result.MbCandidates, MiniblockDescriptor{
 			MiniblockNumber: num,
 			Data:            data,
@@ -1724,7 +2248,7 @@ func (s *PostgresStreamStore) streamLastMiniBlockTx(
 	tx pgx.Tx,
 	streamID StreamId,
 ) (*MiniblockData, error) {
-	_, migrated, err := s.lockStream(ctx, tx, streamID, false)
+	_, layout, err := s.lockStream(ctx, tx, streamID, false)
 	if err != nil {
 		return nil, err
 	}
@@ -1738,7 +2262,7 @@ func (s *PostgresStreamStore) streamLastMiniBlockTx(
 		s.sqlForStream(
 			"SELECT seq_num, blockdata FROM {{miniblocks}} WHERE stream_id = $1 ORDER BY seq_num DESC LIMIT 1",
 			streamID,
-			migrated,
+			layout,
 		),
 		streamID,
 	).Scan(&maxSeqNum, &blockData)
@@ -1751,6 +2275,11 @@ func (s *PostgresStreamStore) streamLastMiniBlockTx(
 		return nil, err
 	}
 
+	blockData, err = s.codec.decode(blockData)
+	if err != nil {
+		return nil, err
+	}
+
 	return &MiniblockData{
 		StreamID:      streamID,
 		Number:        maxSeqNum,
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"reflect"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/rand"
 
@@ -33,6 +35,14 @@ type testStreamStoreParams struct {
 }
 
 func setupStreamStorageTest(t *testing.T, migrateStreamCreation bool) *testStreamStoreParams {
+	return setupStreamStorageTestWithConfig(t, migrateStreamCreation, nil)
+}
+
+func setupStreamStorageTestWithConfig(
+	t *testing.T,
+	migrateStreamCreation bool,
+	configUpdater func(*config.DatabaseConfig),
+) *testStreamStoreParams {
 	ctx, ctxCloser := test.NewTestContext()
 
 	dbCfg, dbSchemaName, dbCloser, err := dbtestutils.ConfigureDB(ctx)
@@ -43,6 +53,9 @@ func setupStreamStorageTest(t *testing.T, migrateStreamCreation bool) *testStrea
 	dbCfg.StartupDelay = 2 * time.Millisecond
 	dbCfg.Extra = strings.Replace(dbCfg.Extra, "pool_max_conns=1000", "pool_max_conns=10", 1)
 	dbCfg.MigrateStreamCreation = migrateStreamCreation
+	if configUpdater != nil {
+		configUpdater(dbCfg)
+	}
 
 	pool, err := CreateAndValidatePgxPool(
 		ctx,
@@ -99,6 +112,7 @@ func TestStoreAgainstMigratedAndLegacySchemas(t *testing.T) {
 		"TestCreateBlockProposalNoSuchStreamError":                             testCreateBlockProposalNoSuchStreamError,
 		"TestPromoteBlockNoSuchStreamError":                                    testPromoteBlockNoSuchStreamError,
 		"TestExitIfSecondStorageCreated":                                       testExitIfSecondStorageCreated,
+		"TestReadOnlyStorageDoesNotTakeOver":                                   testReadOnlyStorageDoesNotTakeOver,
 		"TestGetStreamFromLastSnapshotConsistencyChecksMissingBlockFailure":    testGetStreamFromLastSnapshotConsistencyChecksMissingBlockFailure,
 		"TestGetStreamFromLastSnapshotConsistencyCheckWrongEnvelopeGeneration": testGetStreamFromLastSnapshotConsistencyCheckWrongEnvelopeGeneration,
 		"TestGetStreamFromLastSnapshotConsistencyCheckNoZeroIndexEnvelope":     testGetStreamFromLastSnapshotConsistencyCheckNoZeroIndexEnvelope,
@@ -107,6 +121,7 @@ func TestStoreAgainstMigratedAndLegacySchemas(t *testing.T) {
 		"TestAlreadyExists":                                                    testAlreadyExists,
 		"TestNotFound":                                                         testNotFound,
 		"TestReadStreamFromLastSnapshot":                                       testReadStreamFromLastSnapshot,
+		"TestCollectMiniblockCandidates":                                       testCollectMiniblockCandidates,
 	}
 
 	for name, testFunc := range tests {
@@ -124,9 +139,319 @@ func TestStoreAgainstMigratedAndLegacySchemas(t *testing.T) {
 	}
 }
 
+func TestPostgresStreamStoreConformance(t *testing.T) {
+	for _, migrated := range []bool{false, true} {
+		t.Run(fmt.Sprintf("migrated=%v", migrated), func(t *testing.T) {
+			RunStreamStorageConformanceTests(t, func(ctx context.Context, t *testing.T) StreamStorage {
+				params := setupStreamStorageTest(t, migrated)
+				t.Cleanup(params.closer)
+				return params.pgStreamStore
+			})
+		})
+	}
+}
+
+func compressionConfigUpdater(cfg *config.DatabaseConfig) {
+	cfg.Compression = config.CompressionConfig{
+		Codec:   BlobCodecZstd,
+		MinSize: 1,
+	}
+}
+
+func TestPostgresStreamStoreCompressionConformance(t *testing.T) {
+	RunStreamStorageConformanceTests(t, func(ctx context.Context, t *testing.T) StreamStorage {
+		params := setupStreamStorageTestWithConfig(t, true, compressionConfigUpdater)
+		t.Cleanup(params.closer)
+		return params.pgStreamStore
+	})
+}
+
+func TestPostgresStreamStoreRecompression(t *testing.T) {
+	params := setupStreamStorageTestWithConfig(t, true, func(cfg *config.DatabaseConfig) {
+		compressionConfigUpdater(cfg)
+		cfg.Compression.RecompressionBatchSize = 2
+	})
+	defer params.closer()
+	ctx := params.ctx
+	store := params.pgStreamStore
+	require := require.New(t)
+
+	compressedCodec := store.codec
+	plainCodec, err := newBlobCodec(&config.CompressionConfig{}, infra.NewMetricsFactory(nil, "", ""))
+	require.NoError(err)
+
+	// Write stream without compression.
+	store.codec = plainCodec
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	mbData := func(i int64) []byte {
+		return []byte(strings.Repeat(fmt.Sprintf("miniblock %d data ", i), 20))
+	}
+	require.NoError(store.CreateStreamStorage(ctx, streamId, mbData(0)))
+	for i := int64(1); i < 6; i++ {
+		hash := common.BytesToHash(mbData(i))
+		require.NoError(store.WriteMiniblockCandidate(ctx, streamId, hash, i, mbData(i)))
+		require.NoError(promoteMiniblockCandidate(ctx, store, streamId, i, hash, false, nil))
+	}
+	// Random data doesn't compress.
+	incompressible := make([]byte, 256)
+	_, _ = rand.Read(incompressible)
+	incompressible[0] = 0x0a
+	hash := common.BytesToHash(incompressible)
+	require.NoError(store.WriteMiniblockCandidate(ctx, streamId, hash, 6, incompressible))
+	require.NoError(promoteMiniblockCandidate(ctx, store, streamId, 6, hash, false, nil))
+
+	// Enable compression, previously written data is still readable.
+	store.codec = compressedCodec
+	mbs, err := store.ReadMiniblocks(ctx, streamId, 0, 7)
+	require.NoError(err)
+	require.Len(mbs, 7)
+
+	// Incompressible miniblock is marked as raw, so it is not rewritten by the next pass.
+	count, err := store.RecompressMiniblocks(ctx)
+	require.NoError(err)
+	require.Equal(7, count)
+
+	count, err = store.RecompressMiniblocks(ctx)
+	require.NoError(err)
+	require.Equal(0, count)
+
+	// Raw data is compressed, but reads return original data.
+	var raw []byte
+	require.NoError(store.pool.QueryRow(
+		ctx,
+		store.sqlForStream(
+			"SELECT blockdata FROM {{miniblocks}} WHERE stream_id = $1 AND seq_num = 3",
+			streamId,
+			store.currentLayout(true),
+		),
+		streamId,
+	).Scan(&raw))
+	require.True(isEncodedBlob(raw))
+	require.Less(len(raw), len(mbData(3)))
+
+	require.NoError(store.pool.QueryRow(
+		ctx,
+		store.sqlForStream(
+			"SELECT blockdata FROM {{miniblocks}} WHERE stream_id = $1 AND seq_num = 6",
+			streamId,
+			store.currentLayout(true),
+		),
+		streamId,
+	).Scan(&raw))
+	require.Equal(rawBlob(incompressible), raw)
+
+	mbs2, err := store.ReadMiniblocks(ctx, streamId, 0, 7)
+	require.NoError(err)
+	require.Equal(mbs, mbs2)
+}
+
+func TestPostgresStreamStoreColdStorage(t *testing.T) {
+	objectDir := t.TempDir()
+	params := setupStreamStorageTestWithConfig(t, true, func(cfg *config.DatabaseConfig) {
+		cfg.ColdStorage = config.ColdStorageConfig{
+			Type:           ObjectStoreTypeFs,
+			Fs:             config.FsObjectStoreConfig{Path: objectDir},
+			KeepMiniblocks: 2,
+			RangeSize:      3,
+		}
+	})
+	defer params.closer()
+	ctx := params.ctx
+	store := params.pgStreamStore
+	require := require.New(t)
+
+	// Create stream with miniblocks 0..10 and the last snapshot in miniblock 10.
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(store.CreateStreamStorage(ctx, streamId, mbDataForNumb(0)))
+	for i := int64(1); i <= 10; i++ {
+		hash := common.BytesToHash(mbDataForNumb(i))
+		require.NoError(store.WriteMiniblockCandidate(ctx, streamId, hash, i, mbDataForNumb(i)))
+		require.NoError(promoteMiniblockCandidate(ctx, store, streamId, i, hash, i == 10, nil))
+	}
+	expected, err := store.ReadMiniblocks(ctx, streamId, 0, 11)
+	require.NoError(err)
+	require.Len(expected, 11)
+
+	// Miniblocks before 10-2=8 are eligible, they are offloaded in full ranges of 3: [0..2], [3..5].
+	count, err := store.OffloadMiniblocks(ctx)
+	require.NoError(err)
+	require.Equal(6, count)
+	count, err = store.OffloadMiniblocks(ctx)
+	require.NoError(err)
+	require.Equal(0, count)
+
+	debug, err := store.DebugReadStreamData(ctx, streamId)
+	require.NoError(err)
+	require.Len(debug.Miniblocks, 5)
+	require.EqualValues(6, debug.Miniblocks[0].MiniblockNumber)
+
+	// Reads transparently include offloaded ranges.
+	mbs, err := store.ReadMiniblocks(ctx, streamId, 0, 11)
+	require.NoError(err)
+	require.Equal(expected, mbs)
+	mbs, err = store.ReadMiniblocks(ctx, streamId, 2, 7)
+	require.NoError(err)
+	require.Equal(expected[2:7], mbs)
+	mbs, err = store.ReadMiniblocks(ctx, streamId, 4, 5)
+	require.NoError(err)
+	require.Equal(expected[4:5], mbs)
+
+	result, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.NoError(err)
+	require.EqualValues(10, result.StartMiniblockNumber)
+
+	// Missing object is reported as error.
+	require.NoError(store.objectStore.DeleteObject(
+		ctx,
+		fmt.Sprintf("%s/%s/%020d-%020d", params.schema, streamId, 3, 5),
+	))
+	_, err = store.ReadMiniblocks(ctx, streamId, 0, 11)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+
+	// Deleting stream deletes offloaded objects.
+	require.NoError(store.DeleteStream(ctx, streamId))
+	_, err = store.objectStore.GetObject(ctx, fmt.Sprintf("%s/%s/%020d-%020d", params.schema, streamId, 0, 2))
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+}
+
+func replicaReads(store *PostgresStreamStore, name string, result string) float64 {
+	var m dto.Metric
+	_ = store.replicas.reads.WithLabelValues(name, result).Write(&m)
+	return m.GetCounter().GetValue()
+}
+
+func TestPostgresStreamStoreReadReplicas(t *testing.T) {
+	params := setupStreamStorageTestWithConfig(t, true, func(cfg *config.DatabaseConfig) {
+		// Primary is used as its own replica, it always reports zero lag.
+		cfg.ReadReplicas = config.ReadReplicasConfig{
+			Urls:             cfg.GetUrl(),
+			LagCheckInterval: time.Hour,
+		}
+	})
+	defer params.closer()
+	ctx := params.ctx
+	store := params.pgStreamStore
+	require := require.New(t)
+
+	require.Eventually(func() bool { return store.replicas.pick() != nil }, 10*time.Second, 10*time.Millisecond)
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(store.CreateStreamStorage(ctx, streamId, mbDataForNumb(0)))
+	for i := int64(1); i <= 5; i++ {
+		hash := common.BytesToHash(mbDataForNumb(i))
+		require.NoError(store.WriteMiniblockCandidate(ctx, streamId, hash, i, mbDataForNumb(i)))
+		require.NoError(promoteMiniblockCandidate(ctx, store, streamId, i, hash, false, nil))
+	}
+
+	mbs, err := store.ReadMiniblocks(ctx, streamId, 0, 6)
+	require.NoError(err)
+	require.Len(mbs, 6)
+	require.EqualValues(1, replicaReads(store, "ReadMiniblocks", "replica"))
+	require.EqualValues(0, replicaReads(store, "ReadMiniblocks", "fallback"))
+
+	// Incomplete result is retried on the primary.
+	mbs, err = store.ReadMiniblocks(ctx, streamId, 3, 10)
+	require.NoError(err)
+	require.Len(mbs, 3)
+	require.EqualValues(1, replicaReads(store, "ReadMiniblocks", "fallback"))
+
+	debug, err := store.DebugReadStreamData(ctx, streamId)
+	require.NoError(err)
+	require.Len(debug.Miniblocks, 6)
+	require.EqualValues(1, replicaReads(store, "DebugReadStreamData", "replica"))
+
+	_, err = store.ReadMiniblocks(ctx, testutils.FakeStreamId(STREAM_CHANNEL_BIN), 0, 1)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+	require.EqualValues(2, replicaReads(store, "ReadMiniblocks", "fallback"))
+
+	// Lagging replica is not used.
+	store.replicas.replicas[0].lag.Store(int64(2 * store.replicas.maxLag))
+	require.Nil(store.replicas.pick())
+	mbs, err = store.ReadMiniblocks(ctx, streamId, 0, 6)
+	require.NoError(err)
+	require.Len(mbs, 6)
+	require.EqualValues(1, replicaReads(store, "ReadMiniblocks", "replica"))
+	require.EqualValues(2, replicaReads(store, "ReadMiniblocks", "fallback"))
+}
+
+func TestPostgresStreamStoreRepartitioning(t *testing.T) {
+	params := setupStreamStorageTest(t, true)
+	defer params.closer()
+	ctx := params.ctx
+	store := params.pgStreamStore
+	require := require.New(t)
+	require.Equal(4, store.numPartitions)
+
+	// Pick stream that is stored in different partitions with 4 and 2 partitions.
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	for CreatePartitionSuffix(streamId, 4) == CreatePartitionSuffix(streamId, 2) {
+		streamId = testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	}
+	require.NoError(store.CreateStreamStorage(ctx, streamId, mbDataForNumb(0)))
+	for i := int64(1); i <= 3; i++ {
+		hash := common.BytesToHash(mbDataForNumb(i))
+		require.NoError(store.WriteMiniblockCandidate(ctx, streamId, hash, i, mbDataForNumb(i)))
+		require.NoError(promoteMiniblockCandidate(ctx, store, streamId, i, hash, false, nil))
+	}
+	expected, err := store.ReadMiniblocks(ctx, streamId, 0, 4)
+	require.NoError(err)
+	require.Len(expected, 4)
+
+	// Switch settings to 2 partitions and move the stream the same way river_migrate_db repartition does.
+	_, err = store.pool.Exec(ctx, "UPDATE settings SET num_partitions = 2 WHERE single_row_key = true")
+	require.NoError(err)
+	oldSuffix := CreatePartitionSuffix(streamId, 4)
+	newSuffix := CreatePartitionSuffix(streamId, 2)
+	for _, table := range []string{"minipools", "miniblocks", "miniblock_candidates"} {
+		_, err = store.pool.Exec(
+			ctx,
+			fmt.Sprintf(
+				"INSERT INTO %[1]s_%[2]s SELECT * FROM %[1]s_%[3]s WHERE stream_id = $1",
+				table,
+				newSuffix,
+				oldSuffix,
+			),
+			streamId,
+		)
+		require.NoError(err)
+		_, err = store.pool.Exec(ctx, fmt.Sprintf("DELETE FROM %s_%s WHERE stream_id = $1", table, oldSuffix), streamId)
+		require.NoError(err)
+	}
+	_, err = store.pool.Exec(ctx, "UPDATE es SET num_partitions = 2 WHERE stream_id = $1", streamId)
+	require.NoError(err)
+
+	// Moved stream is read from the new partitions and can be written to.
+	mbs, err := store.ReadMiniblocks(ctx, streamId, 0, 4)
+	require.NoError(err)
+	require.Equal(expected, mbs)
+	hash := common.BytesToHash(mbDataForNumb(4))
+	require.NoError(store.WriteMiniblockCandidate(ctx, streamId, hash, 4, mbDataForNumb(4)))
+	require.NoError(promoteMiniblockCandidate(ctx, store, streamId, 4, hash, true, nil))
+	result, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.NoError(err)
+	require.EqualValues(4, result.StartMiniblockNumber)
+
+	// New streams are created with the partition count from settings.
+	streamId2 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(store.CreateStreamStorage(ctx, streamId2, mbDataForNumb(0)))
+	var numPartitions int
+	require.NoError(
+		store.pool.QueryRow(ctx, "SELECT num_partitions FROM es WHERE stream_id = $1", streamId2).Scan(&numPartitions),
+	)
+	require.Equal(2, numPartitions)
+	mbs, err = store.ReadMiniblocks(ctx, streamId2, 0, 1)
+	require.NoError(err)
+	require.Len(mbs, 1)
+}
+
+// currentLayout returns the layout with the partition count loaded when the store was initialized.
+func (s *PostgresStreamStore) currentLayout(migrated bool) streamLayout {
+	return streamLayout{migrated: migrated, numPartitions: s.numPartitions}
+}
+
 func promoteMiniblockCandidate(
 	ctx context.Context,
-	pgStreamStore *PostgresStreamStore,
+	pgStreamStore StreamStorage,
 	streamId StreamId,
 	mbNum int64,
 	candidateBlockHash common.Hash,
@@ -279,6 +604,74 @@ func testPostgresStreamStore(params *testStreamStoreParams) {
 	}
 }
 
+func testCollectMiniblockCandidates(params *testStreamStoreParams) {
+	t := params.t
+	ctx := params.ctx
+	store := params.pgStreamStore
+	defer params.closer()
+	require := require.New(t)
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(store.CreateStreamStorage(ctx, streamId, []byte("genesisMiniblock")))
+
+	hash1 := common.BytesToHash([]byte("block_hash_1"))
+	hash2 := common.BytesToHash([]byte("block_hash_2"))
+	hash3 := common.BytesToHash([]byte("block_hash_3"))
+	require.NoError(store.WriteMiniblockCandidate(ctx, streamId, hash1, 1, []byte("candidate1")))
+	require.NoError(store.WriteMiniblockCandidate(ctx, streamId, hash2, 2, []byte("candidate2")))
+	require.NoError(store.WriteMiniblockCandidate(ctx, streamId, hash3, 3, []byte("candidate3")))
+	require.NoError(promoteMiniblockCandidate(ctx, store, streamId, 1, hash1, false, nil))
+
+	layout := store.currentLayout(params.config.MigrateStreamCreation)
+	// Lost proposal for the committed miniblock that was left behind.
+	_, err := store.pool.Exec(
+		ctx,
+		store.sqlForStream(
+			"INSERT INTO {{miniblock_candidates}} (stream_id, seq_num, block_hash, blockdata) VALUES ($1, 1, $2, $3)",
+			streamId,
+			layout,
+		),
+		streamId,
+		hex.EncodeToString(hash2.Bytes()),
+		[]byte("lost"),
+	)
+	require.NoError(err)
+	// Candidate that was never committed.
+	_, err = store.pool.Exec(
+		ctx,
+		store.sqlForStream(
+			"UPDATE {{miniblock_candidates}} SET created_at = now() - interval '2 hours' WHERE stream_id = $1 AND seq_num = 3",
+			streamId,
+			layout,
+		),
+		streamId,
+	)
+	require.NoError(err)
+
+	streams, err := store.GetStreamsWithExcessiveCandidates(ctx, 3)
+	require.NoError(err)
+	require.Len(streams, 1)
+	require.Equal(streamId, streams[0].StreamId)
+	require.EqualValues(3, streams[0].Count)
+	require.EqualValues(1, streams[0].MinSeqNum)
+	require.EqualValues(3, streams[0].MaxSeqNum)
+
+	deleted, err := store.CollectMiniblockCandidates(ctx)
+	require.NoError(err)
+	require.EqualValues(2, deleted)
+
+	_, err = store.ReadMiniblockCandidate(ctx, streamId, hash2, 2)
+	require.NoError(err)
+	_, err = store.ReadMiniblockCandidate(ctx, streamId, hash2, 1)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+	_, err = store.ReadMiniblockCandidate(ctx, streamId, hash3, 3)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+
+	streams, err = store.GetStreamsWithExcessiveCandidates(ctx, 2)
+	require.NoError(err)
+	require.Empty(streams)
+}
+
 func testPromoteMiniblockCandidate(params *testStreamStoreParams) {
 	t := params.t
 	ctx := params.ctx
@@ -403,7 +796,7 @@ func testAddEventConsistencyChecksImproperGeneration(params *testStreamStorePara
 		pgStreamStore.sqlForStream(
 			"UPDATE {{minipools}} SET generation = 777 WHERE slot_num = 1",
 			streamId,
-			pgStreamStore.config.MigrateStreamCreation,
+			pgStreamStore.currentLayout(pgStreamStore.config.MigrateStreamCreation),
 		),
 	)
 	err := pgStreamStore.WriteEvent(ctx, streamId, 1, 3, []byte("event4"))
@@ -432,7 +825,7 @@ func testAddEventConsistencyChecksGaps(params *testStreamStoreParams) {
 		pgStreamStore.sqlForStream(
 			"DELETE FROM {{minipools}} WHERE slot_num = 1",
 			streamId,
-			pgStreamStore.config.MigrateStreamCreation,
+			pgStreamStore.currentLayout(pgStreamStore.config.MigrateStreamCreation),
 		),
 	)
 	err := pgStreamStore.WriteEvent(ctx, streamId, 1, 3, []byte("event4"))
@@ -461,7 +854,7 @@ func testAddEventConsistencyChecksEventsNumberMismatch(params *testStreamStorePa
 		pgStreamStore.sqlForStream(
 			"DELETE FROM {{minipools}} WHERE slot_num = 2",
 			streamId,
-			pgStreamStore.config.MigrateStreamCreation,
+			pgStreamStore.currentLayout(pgStreamStore.config.MigrateStreamCreation),
 		),
 	)
 	err := pgStreamStore.WriteEvent(ctx, streamId, 1, 3, []byte("event4"))
@@ -548,7 +941,7 @@ func testPromoteBlockConsistencyChecksProperNewMinipoolGeneration(params *testSt
 		pgStreamStore.sqlForStream(
 			"DELETE FROM {{miniblocks}} WHERE seq_num = 2",
 			streamId,
-			pgStreamStore.config.MigrateStreamCreation,
+			pgStreamStore.currentLayout(pgStreamStore.config.MigrateStreamCreation),
 		),
 	)
 	err := promoteMiniblockCandidate(ctx, pgStreamStore, streamId, 3, blockHash3, false, testEnvelopes3)
@@ -575,7 +968,7 @@ func testCreateBlockProposalNoSuchStreamError(params *testStreamStoreParams) {
 		pgStreamStore.sqlForStream(
 			"DELETE FROM {{miniblocks}}",
 			streamId,
-			pgStreamStore.config.MigrateStreamCreation,
+			pgStreamStore.currentLayout(pgStreamStore.config.MigrateStreamCreation),
 		),
 	)
 
@@ -612,7 +1005,7 @@ func testPromoteBlockNoSuchStreamError(params *testStreamStoreParams) {
 		pgStreamStore.sqlForStream(
 			"DELETE FROM {{miniblocks}}",
 			streamId,
-			pgStreamStore.config.MigrateStreamCreation,
+			pgStreamStore.currentLayout(pgStreamStore.config.MigrateStreamCreation),
 		),
 	)
 
@@ -669,6 +1062,55 @@ func testExitIfSecondStorageCreated(params *testStreamStoreParams) {
 	require.NotNil(result)
 }
 
+func testReadOnlyStorageDoesNotTakeOver(params *testStreamStoreParams) {
+	require := require.New(params.t)
+	ctx := params.ctx
+	pgStreamStore := params.pgStreamStore
+	defer params.closer()
+
+	// Give listener thread some time to start
+	time.Sleep(500 * time.Millisecond)
+
+	genesisMiniblock := []byte("genesisMiniblock")
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMiniblock))
+
+	pool, err := CreateAndValidatePgxPool(
+		ctx,
+		params.config,
+		params.schema,
+		nil,
+	)
+	require.NoError(err)
+
+	readOnlyStore, err := NewReadOnlyPostgresStreamStore(ctx, pool, infra.NewMetricsFactory(nil, "", ""))
+	require.NoError(err)
+
+	streams, err := readOnlyStore.GetStreams(ctx)
+	require.NoError(err)
+	require.Equal([]StreamId{streamId}, streams)
+	result, err := readOnlyStore.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.NoError(err)
+	require.Equal([][]byte{genesisMiniblock}, result.Miniblocks)
+
+	err = readOnlyStore.CreateStreamStorage(ctx, testutils.FakeStreamId(STREAM_CHANNEL_BIN), genesisMiniblock)
+	require.Error(err)
+	err = readOnlyStore.WriteEvent(ctx, streamId, 1, 0, []byte("event"))
+	require.Error(err)
+
+	readOnlyStore.Close(ctx)
+
+	// Give listener thread of the store some time to detect a notification if there was one
+	time.Sleep(500 * time.Millisecond)
+
+	select {
+	case exitErr := <-params.exitSignal:
+		require.NoError(exitErr, "store must not be taken over by the read-only store")
+	default:
+	}
+	require.NoError(pgStreamStore.WriteEvent(ctx, streamId, 1, 0, []byte("event")))
+}
+
 // Test that if there is a gap in miniblocks sequence, we will get error
 func testGetStreamFromLastSnapshotConsistencyChecksMissingBlockFailure(params *testStreamStoreParams) {
 	require := require.New(params.t)
@@ -742,7 +1184,7 @@ func testGetStreamFromLastSnapshotConsistencyChecksMissingBlockFailure(params *t
 		pgStreamStore.sqlForStream(
 			"DELETE FROM {{miniblocks}} WHERE seq_num = 2",
 			streamId,
-			pgStreamStore.config.MigrateStreamCreation,
+			pgStreamStore.currentLayout(pgStreamStore.config.MigrateStreamCreation),
 		),
 	)
 
@@ -809,7 +1251,7 @@ func testGetStreamFromLastSnapshotConsistencyCheckWrongEnvelopeGeneration(params
 		pgStreamStore.sqlForStream(
 			"UPDATE {{minipools}} SET generation = 777 WHERE slot_num = 1",
 			streamId,
-			pgStreamStore.config.MigrateStreamCreation,
+			pgStreamStore.currentLayout(pgStreamStore.config.MigrateStreamCreation),
 		),
 	)
 
@@ -875,7 +1317,7 @@ func testGetStreamFromLastSnapshotConsistencyCheckNoZeroIndexEnvelope(params *te
 		pgStreamStore.sqlForStream(
 			"DELETE FROM {{minipools}} WHERE slot_num = 0",
 			streamId,
-			pgStreamStore.config.MigrateStreamCreation,
+			pgStreamStore.currentLayout(pgStreamStore.config.MigrateStreamCreation),
 		),
 	)
 
@@ -941,7 +1383,7 @@ func testGetStreamFromLastSnapshotConsistencyCheckGapInEnvelopesIndexes(params *
 		pgStreamStore.sqlForStream(
 			"DELETE FROM {{minipools}} WHERE slot_num = 1",
 			streamId,
-			pgStreamStore.config.MigrateStreamCreation,
+			pgStreamStore.currentLayout(pgStreamStore.config.MigrateStreamCreation),
 		),
 	)
 
@@ -1022,7 +1464,7 @@ func testGetMiniblocksConsistencyChecks(params *testStreamStoreParams) {
 		pgStreamStore.sqlForStream(
 			"DELETE FROM {{miniblocks}} WHERE seq_num = 2",
 			streamId,
-			pgStreamStore.config.MigrateStreamCreation,
+			pgStreamStore.currentLayout(pgStreamStore.config.MigrateStreamCreation),
 		),
 	)
 
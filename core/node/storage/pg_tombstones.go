@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+
+	. "github.com/river-build/river/core/node/shared"
+)
+
+func (s *PostgresStreamStore) WriteStreamTombstone(ctx context.Context, tombstone *StreamTombstone) error {
+	return s.txRunnerWithUUIDCheck(
+		ctx,
+		"WriteStreamTombstone",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(
+				ctx,
+				`INSERT INTO stream_tombstones (stream_id, deleted_at, requester, reason) VALUES ($1, $2, $3, $4)
+				ON CONFLICT (stream_id) DO NOTHING`,
+				tombstone.StreamId,
+				tombstone.DeletedAt,
+				tombstone.Requester.Bytes(),
+				tombstone.Reason,
+			)
+			return err
+		},
+		nil,
+		"streamId", tombstone.StreamId,
+	)
+}
+
+func (s *PostgresStreamStore) ReadStreamTombstones(ctx context.Context) ([]*StreamTombstone, error) {
+	var ret []*StreamTombstone
+	err := s.txRunnerWithUUIDCheck(
+		ctx,
+		"ReadStreamTombstones",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			ret = nil
+			rows, _ := tx.Query(ctx, "SELECT stream_id, deleted_at, requester, reason FROM stream_tombstones")
+			var (
+				streamId  StreamId
+				deletedAt time.Time
+				requester []byte
+				reason    string
+			)
+			_, err := pgx.ForEachRow(rows, []any{&streamId, &deletedAt, &requester, &reason}, func() error {
+				ret = append(ret, &StreamTombstone{
+					StreamId:  streamId,
+					DeletedAt: deletedAt,
+					Requester: common.BytesToAddress(requester),
+					Reason:    reason,
+				})
+				return nil
+			})
+			return err
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
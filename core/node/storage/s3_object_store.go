@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+// S3ObjectStore is ObjectStore backed by S3-compatible service.
+// Requests use path-style addressing and are signed with AWS Signature Version 4.
+type S3ObjectStore struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	prefix    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+}
+
+var _ ObjectStore = (*S3ObjectStore)(nil)
+
+func NewS3ObjectStore(cfg *config.S3ObjectStoreConfig) (*S3ObjectStore, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, RiverError(Err_BAD_CONFIG, "Bad S3 endpoint", "endpoint", cfg.Endpoint).Func("NewS3ObjectStore")
+	}
+	if cfg.Bucket == "" || cfg.Region == "" {
+		return nil, RiverError(Err_BAD_CONFIG, "S3 bucket and region must be set").Func("NewS3ObjectStore")
+	}
+	return &S3ObjectStore{
+		endpoint:  endpoint,
+		region:    cfg.Region,
+		bucket:    cfg.Bucket,
+		prefix:    cfg.Prefix,
+		accessKey: cfg.AccessKeyId,
+		secretKey: cfg.SecretAccessKey,
+		client:    &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+func (s *S3ObjectStore) PutObject(ctx context.Context, key string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return AsRiverError(err).Func("S3ObjectStore.PutObject")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.statusError(resp, key).Func("S3ObjectStore.PutObject")
+	}
+	return nil
+}
+
+func (s *S3ObjectStore) GetObject(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, AsRiverError(err).Func("S3ObjectStore.GetObject")
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, AsRiverError(err, Err_UNAVAILABLE).Func("S3ObjectStore.GetObject").Tag("key", key)
+		}
+		return data, nil
+	case http.StatusNotFound:
+		return nil, RiverError(Err_NOT_FOUND, "Object not found", "key", key).Func("S3ObjectStore.GetObject")
+	default:
+		return nil, s.statusError(resp, key).Func("S3ObjectStore.GetObject")
+	}
+}
+
+func (s *S3ObjectStore) DeleteObject(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return AsRiverError(err).Func("S3ObjectStore.DeleteObject")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusNotFound {
+		return s.statusError(resp, key).Func("S3ObjectStore.DeleteObject")
+	}
+	return nil
+}
+
+func (s *S3ObjectStore) statusError(resp *http.Response, key string) *RiverErrorImpl {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return RiverError(Err_UNAVAILABLE, "S3 request failed",
+		"key", key,
+		"status", resp.StatusCode,
+		"response", string(body),
+	)
+}
+
+func (s *S3ObjectStore) do(ctx context.Context, method string, key string, body []byte) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.bucket + "/" + s.prefix + key
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, AsRiverError(err, Err_INTERNAL).Tag("key", key)
+	}
+	req.ContentLength = int64(len(body))
+	signS3Request(req, body, s.region, s.accessKey, s.secretKey, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, AsRiverError(err, Err_UNAVAILABLE).Message("S3 request failed").Tag("key", key)
+	}
+	return resp, nil
+}
+
+// signS3Request adds AWS Signature Version 4 headers to the request.
+func signS3Request(req *http.Request, body []byte, region, accessKey, secretKey string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	signature, signedHeaders, scope := s3Signature(req, payloadHash, amzDate, dateStamp, region, secretKey)
+	req.Header.Set(
+		"Authorization",
+		"AWS4-HMAC-SHA256 Credential="+accessKey+"/"+scope+
+			", SignedHeaders="+signedHeaders+
+			", Signature="+signature,
+	)
+}
+
+func s3Signature(
+	req *http.Request,
+	payloadHash, amzDate, dateStamp, region, secretKey string,
+) (signature string, signedHeaders string, scope string) {
+	signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + payloadHash + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope = dateStamp + "/" + region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSha256([]byte("AWS4"+secretKey), dateStamp)
+	key = hmacSha256(key, region)
+	key = hmacSha256(key, "s3")
+	key = hmacSha256(key, "aws4_request")
+	return hex.EncodeToString(hmacSha256(key, stringToSign)), signedHeaders, scope
+}
+
+func sha256Hex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSha256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -2,14 +2,19 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
 	. "github.com/river-build/river/core/node/shared"
 )
 
 const (
 	StreamStorageTypePostgres = "postgres"
+	StreamStorageTypeLevelDb  = "leveldb"
+	StreamStorageTypeMemory   = "memory"
 )
 
 type ReadStreamFromLastSnapshotResult struct {
@@ -35,9 +40,23 @@ type StreamStorage interface {
 		numToRead int,
 	) (*ReadStreamFromLastSnapshotResult, error)
 
+	// ReadStreamsFromLastSnapshot is the batched version of ReadStreamFromLastSnapshot that reads given streams
+	// in a single storage operation. Streams not found in storage are omitted from the result.
+	ReadStreamsFromLastSnapshot(
+		ctx context.Context,
+		streamIds []StreamId,
+		numToRead int,
+	) (map[StreamId]*ReadStreamFromLastSnapshotResult, error)
+
 	// Returns miniblocks with miniblockNum or "generation" from fromInclusive, to toExlusive.
+	// Err_MINIBLOCKS_PRUNED is returned if fromInclusive precedes the first miniblock retained after pruning.
 	ReadMiniblocks(ctx context.Context, streamId StreamId, fromInclusive int64, toExclusive int64) ([][]byte, error)
 
+	// PruneMiniblocks deletes miniblocks preceding the retainSnapshots-th most recent snapshot miniblock.
+	// Nothing is pruned if retainSnapshots is not positive or if stream has fewer snapshots.
+	// Returns the number of the first miniblock retained in storage.
+	PruneMiniblocks(ctx context.Context, streamId StreamId, retainSnapshots int) (int64, error)
+
 	// Adds event to the given minipool.
 	// Current generation of minipool should match minipoolGeneration,
 	// and there should be exactly minipoolSlot events in the minipool.
@@ -105,6 +124,24 @@ type StreamStorage interface {
 		miniblocks [][]byte,
 	) error
 
+	// GetStreams returns ids of all streams in storage.
+	GetStreams(ctx context.Context) ([]StreamId, error)
+
+	// GetStreamsStorageUsage returns the number of rows and bytes stored for each stream.
+	// It scans all stream data and is intended for debug and operator tooling.
+	GetStreamsStorageUsage(ctx context.Context) ([]*StreamStorageUsage, error)
+
+	// DeleteStream deletes all data of the stream. NOT_FOUND is returned if the stream is not in storage.
+	DeleteStream(ctx context.Context, streamId StreamId) error
+
+	// WriteStreamTombstone records that the stream is deleted. Tombstones are kept after stream data is deleted,
+	// so deleted streams are not recreated from replicas or the registry.
+	// If the stream already has a tombstone, the first record is kept.
+	WriteStreamTombstone(ctx context.Context, tombstone *StreamTombstone) error
+
+	// ReadStreamTombstones returns tombstones of all deleted streams.
+	ReadStreamTombstones(ctx context.Context) ([]*StreamTombstone, error)
+
 	DebugReadStreamData(
 		ctx context.Context,
 		streamId StreamId,
@@ -123,6 +160,14 @@ type WriteMiniblockData struct {
 	Data     []byte
 }
 
+// StreamTombstone records who deleted the stream, when and why.
+type StreamTombstone struct {
+	StreamId  StreamId       `json:"streamId"`
+	DeletedAt time.Time      `json:"deletedAt"`
+	Requester common.Address `json:"requester"`
+	Reason    string         `json:"reason"`
+}
+
 type MiniblockData struct {
 	StreamID      StreamId
 	Number        int64
@@ -149,3 +194,14 @@ type DebugReadStreamDataResult struct {
 	Events                     []EventDescriptor
 	MbCandidates               []MiniblockDescriptor
 }
+
+// miniblocksPrunedError is returned by ReadMiniblocks for ranges that start before the first retained miniblock.
+func miniblocksPrunedError(streamId StreamId, fromInclusive int64, firstMiniblock int64) *RiverErrorImpl {
+	return RiverError(
+		Err_MINIBLOCKS_PRUNED,
+		"Miniblocks pruned",
+		"streamId", streamId,
+		"fromInclusive", fromInclusive,
+		"firstAvailableMiniblock", firstMiniblock,
+	)
+}
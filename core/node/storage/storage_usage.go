@@ -0,0 +1,144 @@
+package storage
+
+import (
+	"cmp"
+	"encoding/hex"
+	"slices"
+	"time"
+
+	. "github.com/river-build/river/core/node/shared"
+)
+
+const defaultStorageUsageTopN = 20
+
+// StorageUsage is the number of rows and bytes of stream data in storage.
+// Bytes are counted as stored, i.e. after compression.
+type StorageUsage struct {
+	Miniblocks      int64 `json:"miniblocks"`
+	MiniblocksBytes int64 `json:"miniblocksBytes"`
+	MinipoolEvents  int64 `json:"minipoolEvents"`
+	MinipoolBytes   int64 `json:"minipoolBytes"`
+	Candidates      int64 `json:"candidates"`
+	CandidatesBytes int64 `json:"candidatesBytes"`
+}
+
+func (u *StorageUsage) TotalBytes() int64 {
+	return u.MiniblocksBytes + u.MinipoolBytes + u.CandidatesBytes
+}
+
+func (u *StorageUsage) add(other *StorageUsage) {
+	u.Miniblocks += other.Miniblocks
+	u.MiniblocksBytes += other.MiniblocksBytes
+	u.MinipoolEvents += other.MinipoolEvents
+	u.MinipoolBytes += other.MinipoolBytes
+	u.Candidates += other.Candidates
+	u.CandidatesBytes += other.CandidatesBytes
+}
+
+// StreamStorageUsage is the storage usage of a single stream.
+type StreamStorageUsage struct {
+	StreamId StreamId `json:"streamId"`
+	StorageUsage
+}
+
+// StorageUsageSample is the storage usage of all streams at the given time.
+type StorageUsageSample struct {
+	Time    time.Time
+	Streams []*StreamStorageUsage
+}
+
+// StreamUsageGrowth is the storage usage of a stream and its growth since the previous sample.
+type StreamUsageGrowth struct {
+	StreamStorageUsage
+	GrowthBytes int64 `json:"growthBytes"`
+}
+
+// StreamTypeUsageReport lists the largest and the fastest growing streams of a single stream type.
+type StreamTypeUsageReport struct {
+	// Type is the stream type prefix in hex, i.e. "20" for channels.
+	Type           string                `json:"type"`
+	Streams        int                   `json:"streams"`
+	Total          StorageUsage          `json:"total"`
+	Largest        []*StreamStorageUsage `json:"largest"`
+	FastestGrowing []*StreamUsageGrowth  `json:"fastestGrowing,omitempty"`
+}
+
+type StorageUsageReport struct {
+	Time time.Time `json:"time"`
+	// GrowthPeriod is the time since the previous sample growth is computed for, 0 if there is no previous sample.
+	GrowthPeriod time.Duration            `json:"growthPeriod"`
+	Streams      int                      `json:"streams"`
+	Total        StorageUsage             `json:"total"`
+	Types        []*StreamTypeUsageReport `json:"types"`
+}
+
+// NewStorageUsageReport groups streams of the sample by type and lists topN largest streams of each type.
+// If previous sample is given, topN streams that grew the most since then are listed as well.
+// Types are ordered by total size.
+func NewStorageUsageReport(current *StorageUsageSample, previous *StorageUsageSample, topN int) *StorageUsageReport {
+	if topN <= 0 {
+		topN = defaultStorageUsageTopN
+	}
+
+	report := &StorageUsageReport{
+		Time:    current.Time,
+		Streams: len(current.Streams),
+	}
+
+	var prevBytes map[StreamId]int64
+	if previous != nil {
+		report.GrowthPeriod = current.Time.Sub(previous.Time)
+		prevBytes = make(map[StreamId]int64, len(previous.Streams))
+		for _, u := range previous.Streams {
+			prevBytes[u.StreamId] = u.TotalBytes()
+		}
+	}
+
+	types := make(map[byte]*StreamTypeUsageReport)
+	var growth []*StreamUsageGrowth
+	for _, u := range current.Streams {
+		report.Total.add(&u.StorageUsage)
+
+		t, ok := types[u.StreamId.Type()]
+		if !ok {
+			t = &StreamTypeUsageReport{Type: hex.EncodeToString([]byte{u.StreamId.Type()})}
+			types[u.StreamId.Type()] = t
+		}
+		t.Streams++
+		t.Total.add(&u.StorageUsage)
+		t.Largest = append(t.Largest, u)
+
+		if prevBytes != nil {
+			if g := u.TotalBytes() - prevBytes[u.StreamId]; g > 0 {
+				growth = append(growth, &StreamUsageGrowth{StreamStorageUsage: *u, GrowthBytes: g})
+			}
+		}
+	}
+
+	slices.SortFunc(growth, func(a, b *StreamUsageGrowth) int {
+		return cmp.Compare(b.GrowthBytes, a.GrowthBytes)
+	})
+	for _, g := range growth {
+		t := types[g.StreamId.Type()]
+		if len(t.FastestGrowing) < topN {
+			t.FastestGrowing = append(t.FastestGrowing, g)
+		}
+	}
+
+	for _, t := range types {
+		slices.SortFunc(t.Largest, func(a, b *StreamStorageUsage) int {
+			return cmp.Compare(b.TotalBytes(), a.TotalBytes())
+		})
+		if len(t.Largest) > topN {
+			t.Largest = t.Largest[:topN]
+		}
+		report.Types = append(report.Types, t)
+	}
+	slices.SortFunc(report.Types, func(a, b *StreamTypeUsageReport) int {
+		if c := cmp.Compare(b.Total.TotalBytes(), a.Total.TotalBytes()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Type, b.Type)
+	})
+	return report
+}
@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestStorageUsageReport(t *testing.T) {
+	require := require.New(t)
+
+	channel1 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	channel2 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	channel3 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	media := testutils.FakeStreamId(STREAM_MEDIA_BIN)
+
+	usage := func(id StreamId, miniblocksBytes int64) *StreamStorageUsage {
+		return &StreamStorageUsage{
+			StreamId:     id,
+			StorageUsage: StorageUsage{Miniblocks: 1, MiniblocksBytes: miniblocksBytes, MinipoolBytes: 10},
+		}
+	}
+
+	now := time.Now()
+	previous := &StorageUsageSample{
+		Time: now.Add(-time.Hour),
+		Streams: []*StreamStorageUsage{
+			usage(channel1, 1000),
+			usage(channel2, 100),
+			usage(media, 10000),
+		},
+	}
+	current := &StorageUsageSample{
+		Time: now,
+		Streams: []*StreamStorageUsage{
+			usage(channel1, 1100),
+			usage(channel2, 900),
+			usage(channel3, 50),
+			usage(media, 10000),
+		},
+	}
+
+	report := NewStorageUsageReport(current, nil, 2)
+	require.Equal(4, report.Streams)
+	require.Zero(report.GrowthPeriod)
+	require.EqualValues(12050+40, report.Total.TotalBytes())
+	require.Len(report.Types, 2)
+	require.Equal("ff", report.Types[0].Type)
+	require.Equal("20", report.Types[1].Type)
+	require.Equal(3, report.Types[1].Streams)
+	require.Len(report.Types[1].Largest, 2)
+	require.Equal(channel1, report.Types[1].Largest[0].StreamId)
+	require.Equal(channel2, report.Types[1].Largest[1].StreamId)
+	require.Empty(report.Types[1].FastestGrowing)
+
+	report = NewStorageUsageReport(current, previous, 2)
+	require.Equal(time.Hour, report.GrowthPeriod)
+	require.Empty(report.Types[0].FastestGrowing)
+	growing := report.Types[1].FastestGrowing
+	require.Len(growing, 2)
+	require.Equal(channel2, growing[0].StreamId)
+	require.EqualValues(800, growing[0].GrowthBytes)
+	require.Equal(channel1, growing[1].StreamId)
+	require.EqualValues(100, growing[1].GrowthBytes)
+}
@@ -0,0 +1,782 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+// StreamStorageFactory creates a new empty StreamStorage for a single conformance test.
+// Factory is responsible for releasing resources, i.e. by registering t.Cleanup.
+type StreamStorageFactory func(ctx context.Context, t *testing.T) StreamStorage
+
+// RunStreamStorageConformanceTests runs scenarios that define the behavioral contract of StreamStorage
+// against storage instances created by the given factory.
+// Every StreamStorage implementation, including mocks, is expected to pass them.
+func RunStreamStorageConformanceTests(t *testing.T, factory StreamStorageFactory) {
+	tests := []struct {
+		name string
+		fn   func(*conformanceTest)
+	}{
+		{"CreateStream", testConformanceCreateStream},
+		{"NotFound", testConformanceNotFound},
+		{"GetStreams", testConformanceGetStreams},
+		{"WriteEventGeneration", testConformanceWriteEventGeneration},
+		{"WriteEventSlot", testConformanceWriteEventSlot},
+		{"WriteMiniblocksArgs", testConformanceWriteMiniblocksArgs},
+		{"WriteMiniblocksConsistency", testConformanceWriteMiniblocksConsistency},
+		{"MiniblockCandidates", testConformanceMiniblockCandidates},
+		{"CandidateCleanup", testConformanceCandidateCleanup},
+		{"ReadStreamFromLastSnapshot", testConformanceReadStreamFromLastSnapshot},
+		{"ReadStreamsFromLastSnapshot", testConformanceReadStreamsFromLastSnapshot},
+		{"ReadMiniblocks", testConformanceReadMiniblocks},
+		{"PruneMiniblocks", testConformancePruneMiniblocks},
+		{"Archive", testConformanceArchive},
+		{"StorageUsage", testConformanceStorageUsage},
+		{"DeleteStream", testConformanceDeleteStream},
+		{"ConcurrentCreate", testConformanceConcurrentCreate},
+		{"ConcurrentWriteEvent", testConformanceConcurrentWriteEvent},
+		{"ConcurrentStreams", testConformanceConcurrentStreams},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := test.NewTestContext()
+			defer cancel()
+			tt.fn(&conformanceTest{
+				t:       t,
+				ctx:     ctx,
+				store:   factory(ctx, t),
+				require: require.New(t),
+			})
+		})
+	}
+}
+
+type conformanceTest struct {
+	t       *testing.T
+	ctx     context.Context
+	store   StreamStorage
+	require *require.Assertions
+}
+
+func (c *conformanceTest) requireCode(err error, code Err) {
+	c.t.Helper()
+	c.require.Error(err)
+	c.require.Equal(code, AsRiverError(err).Code, err)
+}
+
+func conformanceMb(streamId StreamId, num int64) ([]byte, common.Hash) {
+	data := []byte(fmt.Sprintf("mb-%s-%d", streamId, num))
+	return data, common.BytesToHash(data)
+}
+
+func conformanceEvents(prefix string, n int) [][]byte {
+	var ret [][]byte
+	for i := range n {
+		ret = append(ret, []byte(fmt.Sprintf("%s-event-%d", prefix, i)))
+	}
+	return ret
+}
+
+// createStream creates stream with the given number of miniblocks after genesis,
+// marking miniblocks in snapshots as snapshots, and leaves minipool with given envelopes.
+// Returns all miniblocks including genesis.
+func (c *conformanceTest) createStream(
+	numMiniblocks int64,
+	snapshots []int64,
+	envelopes [][]byte,
+) (StreamId, [][]byte) {
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	genesis, _ := conformanceMb(streamId, 0)
+	c.require.NoError(c.store.CreateStreamStorage(c.ctx, streamId, genesis))
+	mbs := [][]byte{genesis}
+	for i := int64(1); i <= numMiniblocks; i++ {
+		mb, h := conformanceMb(streamId, i)
+		mbs = append(mbs, mb)
+		c.require.NoError(c.store.WriteMiniblockCandidate(c.ctx, streamId, h, i, mb))
+		var pool [][]byte
+		if i == numMiniblocks {
+			pool = envelopes
+		}
+		c.require.NoError(c.writeMiniblock(streamId, i, h, mb, isIn(i, snapshots), pool, -1))
+	}
+	if numMiniblocks == 0 {
+		for i, e := range envelopes {
+			c.require.NoError(c.store.WriteEvent(c.ctx, streamId, 1, i, e))
+		}
+	}
+	return streamId, mbs
+}
+
+func isIn(n int64, list []int64) bool {
+	for _, v := range list {
+		if v == n {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *conformanceTest) writeMiniblock(
+	streamId StreamId,
+	num int64,
+	hash common.Hash,
+	data []byte,
+	snapshot bool,
+	envelopes [][]byte,
+	prevMinipoolSize int,
+) error {
+	return c.store.WriteMiniblocks(
+		c.ctx,
+		streamId,
+		[]*WriteMiniblockData{{Number: num, Hash: hash, Snapshot: snapshot, Data: data}},
+		num+1,
+		envelopes,
+		num,
+		prevMinipoolSize,
+	)
+}
+
+func testConformanceCreateStream(c *conformanceTest) {
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	genesis := []byte("genesis")
+	c.require.NoError(c.store.CreateStreamStorage(c.ctx, streamId, genesis))
+
+	result, err := c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, 0)
+	c.require.NoError(err)
+	c.require.EqualValues(0, result.StartMiniblockNumber)
+	c.require.EqualValues(0, result.SnapshotMiniblockOffset)
+	c.require.Equal([][]byte{genesis}, result.Miniblocks)
+	c.require.Empty(result.MinipoolEnvelopes)
+
+	last, err := c.store.StreamLastMiniBlock(c.ctx, streamId)
+	c.require.NoError(err)
+	c.require.EqualValues(0, last.Number)
+	c.require.Equal(genesis, last.MiniBlockInfo)
+
+	c.requireCode(c.store.CreateStreamStorage(c.ctx, streamId, []byte("genesis2")), Err_ALREADY_EXISTS)
+	c.requireCode(c.store.CreateStreamArchiveStorage(c.ctx, streamId), Err_ALREADY_EXISTS)
+
+	debug, err := c.store.DebugReadStreamData(c.ctx, streamId)
+	c.require.NoError(err)
+	c.require.Equal(streamId, debug.StreamId)
+	c.require.EqualValues(0, debug.LatestSnapshotMiniblockNum)
+	c.require.Len(debug.Miniblocks, 1)
+	c.require.Len(debug.Events, 1, "minipool should contain generation marker only")
+	c.require.EqualValues(1, debug.Events[0].Generation)
+	c.require.EqualValues(-1, debug.Events[0].Slot)
+}
+
+func testConformanceGetStreams(c *conformanceTest) {
+	streams, err := c.store.GetStreams(c.ctx)
+	c.require.NoError(err)
+	c.require.Empty(streams)
+
+	streamId1, _ := c.createStream(0, nil, nil)
+	streamId2, _ := c.createStream(2, nil, nil)
+	archiveId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	c.require.NoError(c.store.CreateStreamArchiveStorage(c.ctx, archiveId))
+
+	streams, err = c.store.GetStreams(c.ctx)
+	c.require.NoError(err)
+	c.require.ElementsMatch([]StreamId{streamId1, streamId2, archiveId}, streams)
+}
+
+func testConformanceStorageUsage(c *conformanceTest) {
+	usage, err := c.store.GetStreamsStorageUsage(c.ctx)
+	c.require.NoError(err)
+	c.require.Empty(usage)
+
+	envelopes := conformanceEvents("usage", 3)
+	streamId1, mbs := c.createStream(2, nil, envelopes)
+	candidate, candidateHash := conformanceMb(streamId1, 3)
+	c.require.NoError(c.store.WriteMiniblockCandidate(c.ctx, streamId1, candidateHash, 3, candidate))
+	streamId2, _ := c.createStream(0, nil, nil)
+	archiveId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	c.require.NoError(c.store.CreateStreamArchiveStorage(c.ctx, archiveId))
+
+	usage, err = c.store.GetStreamsStorageUsage(c.ctx)
+	c.require.NoError(err)
+	c.require.Len(usage, 3)
+	byId := make(map[StreamId]*StreamStorageUsage)
+	for _, u := range usage {
+		byId[u.StreamId] = u
+	}
+
+	// Stored size may include encoding overhead, but is never less than the size of the data.
+	u := byId[streamId1]
+	c.require.NotNil(u)
+	c.require.EqualValues(3, u.Miniblocks)
+	c.require.GreaterOrEqual(u.MiniblocksBytes, int64(len(mbs[0])+len(mbs[1])+len(mbs[2])))
+	c.require.EqualValues(3, u.MinipoolEvents)
+	c.require.GreaterOrEqual(u.MinipoolBytes, int64(len(envelopes[0])+len(envelopes[1])+len(envelopes[2])))
+	c.require.EqualValues(1, u.Candidates)
+	c.require.GreaterOrEqual(u.CandidatesBytes, int64(len(candidate)))
+
+	u = byId[streamId2]
+	c.require.NotNil(u)
+	c.require.EqualValues(1, u.Miniblocks)
+	c.require.Zero(u.MinipoolEvents)
+	c.require.Zero(u.Candidates)
+
+	u = byId[archiveId]
+	c.require.NotNil(u)
+	c.require.Zero(u.TotalBytes())
+}
+
+func testConformanceDeleteStream(c *conformanceTest) {
+	tombstones, err := c.store.ReadStreamTombstones(c.ctx)
+	c.require.NoError(err)
+	c.require.Empty(tombstones)
+
+	streamId, _ := c.createStream(2, nil, conformanceEvents("delete", 2))
+	otherId, _ := c.createStream(0, nil, nil)
+
+	tombstone := &StreamTombstone{
+		StreamId:  streamId,
+		DeletedAt: time.UnixMilli(time.Now().UnixMilli()),
+		Requester: common.BytesToAddress([]byte("requester")),
+		Reason:    "user request",
+	}
+	c.require.NoError(c.store.WriteStreamTombstone(c.ctx, tombstone))
+	c.require.NoError(c.store.DeleteStream(c.ctx, streamId))
+
+	// The first tombstone is kept.
+	c.require.NoError(c.store.WriteStreamTombstone(c.ctx, &StreamTombstone{StreamId: streamId, Reason: "again"}))
+
+	tombstones, err = c.store.ReadStreamTombstones(c.ctx)
+	c.require.NoError(err)
+	c.require.Len(tombstones, 1)
+	c.require.Equal(tombstone.StreamId, tombstones[0].StreamId)
+	c.require.True(tombstone.DeletedAt.Equal(tombstones[0].DeletedAt))
+	c.require.Equal(tombstone.Requester, tombstones[0].Requester)
+	c.require.Equal(tombstone.Reason, tombstones[0].Reason)
+
+	_, err = c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, 0)
+	c.requireCode(err, Err_NOT_FOUND)
+	c.requireCode(c.store.DeleteStream(c.ctx, streamId), Err_NOT_FOUND)
+
+	streams, err := c.store.GetStreams(c.ctx)
+	c.require.NoError(err)
+	c.require.Equal([]StreamId{otherId}, streams)
+}
+
+func testConformanceNotFound(c *conformanceTest) {
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	hash := common.BytesToHash([]byte("hash"))
+
+	res, err := c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, 0)
+	c.require.Nil(res)
+	c.requireCode(err, Err_NOT_FOUND)
+
+	_, err = c.store.ReadMiniblocks(c.ctx, streamId, 0, 1)
+	c.requireCode(err, Err_NOT_FOUND)
+
+	c.requireCode(c.store.WriteEvent(c.ctx, streamId, 1, 0, []byte("event")), Err_NOT_FOUND)
+	c.requireCode(c.store.WriteMiniblockCandidate(c.ctx, streamId, hash, 1, []byte("mb")), Err_NOT_FOUND)
+
+	_, err = c.store.ReadMiniblockCandidate(c.ctx, streamId, hash, 1)
+	c.requireCode(err, Err_NOT_FOUND)
+
+	c.requireCode(c.writeMiniblock(streamId, 1, hash, []byte("mb"), false, nil, -1), Err_NOT_FOUND)
+
+	num, err := c.store.GetMaxArchivedMiniblockNumber(c.ctx, streamId)
+	c.require.EqualValues(-1, num)
+	c.requireCode(err, Err_NOT_FOUND)
+
+	c.requireCode(c.store.WriteArchiveMiniblocks(c.ctx, streamId, 0, [][]byte{[]byte("mb")}), Err_NOT_FOUND)
+
+	_, err = c.store.DebugReadStreamData(c.ctx, streamId)
+	c.requireCode(err, Err_NOT_FOUND)
+
+	_, err = c.store.StreamLastMiniBlock(c.ctx, streamId)
+	c.requireCode(err, Err_NOT_FOUND)
+
+	_, err = c.store.PruneMiniblocks(c.ctx, streamId, 1)
+	c.requireCode(err, Err_NOT_FOUND)
+}
+
+func testConformanceWriteEventGeneration(c *conformanceTest) {
+	streamId, _ := c.createStream(1, nil, conformanceEvents("a", 2))
+
+	// Minipool generation is the number of the next miniblock.
+	err := c.store.WriteEvent(c.ctx, streamId, 1, 2, []byte("event"))
+	c.requireCode(err, Err_DB_OPERATION_FAILURE)
+	c.require.Contains(err.Error(), "Wrong event generation in minipool")
+
+	err = c.store.WriteEvent(c.ctx, streamId, 3, 2, []byte("event"))
+	c.requireCode(err, Err_DB_OPERATION_FAILURE)
+
+	c.require.NoError(c.store.WriteEvent(c.ctx, streamId, 2, 2, []byte("event")))
+
+	result, err := c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, 0)
+	c.require.NoError(err)
+	c.require.Equal(append(conformanceEvents("a", 2), []byte("event")), result.MinipoolEnvelopes)
+}
+
+func testConformanceWriteEventSlot(c *conformanceTest) {
+	streamId, _ := c.createStream(0, nil, conformanceEvents("a", 3))
+
+	for _, slot := range []int{0, 2, 4} {
+		err := c.store.WriteEvent(c.ctx, streamId, 1, slot, []byte("event"))
+		c.requireCode(err, Err_DB_OPERATION_FAILURE)
+		c.require.Contains(err.Error(), "Wrong number of records in minipool")
+	}
+
+	c.require.NoError(c.store.WriteEvent(c.ctx, streamId, 1, 3, []byte("event")))
+
+	result, err := c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, 0)
+	c.require.NoError(err)
+	c.require.Len(result.MinipoolEnvelopes, 4)
+}
+
+func testConformanceWriteMiniblocksArgs(c *conformanceTest) {
+	streamId, _ := c.createStream(0, nil, nil)
+	mb, h := conformanceMb(streamId, 1)
+	mb2, h2 := conformanceMb(streamId, 2)
+
+	err := c.store.WriteMiniblocks(c.ctx, streamId, nil, 2, nil, 1, -1)
+	c.requireCode(err, Err_INTERNAL)
+
+	// Previous minipool generation must be the number of the first miniblock.
+	err = c.store.WriteMiniblocks(
+		c.ctx, streamId, []*WriteMiniblockData{{Number: 1, Hash: h, Data: mb}}, 2, nil, 2, -1)
+	c.requireCode(err, Err_INTERNAL)
+
+	// New minipool generation must follow the last miniblock.
+	err = c.store.WriteMiniblocks(
+		c.ctx, streamId, []*WriteMiniblockData{{Number: 1, Hash: h, Data: mb}}, 3, nil, 1, -1)
+	c.requireCode(err, Err_INTERNAL)
+
+	// Miniblocks must be sequential.
+	err = c.store.WriteMiniblocks(
+		c.ctx,
+		streamId,
+		[]*WriteMiniblockData{{Number: 1, Hash: h, Data: mb}, {Number: 3, Hash: h2, Data: mb2}},
+		4,
+		nil,
+		1,
+		-1,
+	)
+	c.requireCode(err, Err_INTERNAL)
+
+	// Several miniblocks can be written at once.
+	err = c.store.WriteMiniblocks(
+		c.ctx,
+		streamId,
+		[]*WriteMiniblockData{{Number: 1, Hash: h, Data: mb}, {Number: 2, Hash: h2, Data: mb2, Snapshot: true}},
+		3,
+		conformanceEvents("b", 2),
+		1,
+		0,
+	)
+	c.require.NoError(err)
+
+	result, err := c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, 0)
+	c.require.NoError(err)
+	c.require.EqualValues(2, result.StartMiniblockNumber)
+	c.require.EqualValues(0, result.SnapshotMiniblockOffset)
+	c.require.Equal([][]byte{mb2}, result.Miniblocks)
+	c.require.Equal(conformanceEvents("b", 2), result.MinipoolEnvelopes)
+}
+
+func testConformanceWriteMiniblocksConsistency(c *conformanceTest) {
+	streamId, _ := c.createStream(2, nil, conformanceEvents("a", 3))
+
+	// Miniblock that is already in storage.
+	mb2, h2 := conformanceMb(streamId, 2)
+	err := c.writeMiniblock(streamId, 2, h2, mb2, false, nil, -1)
+	c.requireCode(err, Err_INTERNAL)
+	c.require.Contains(err.Error(), "Previous minipool generation mismatch")
+
+	// Miniblock after the gap.
+	mb4, h4 := conformanceMb(streamId, 4)
+	err = c.writeMiniblock(streamId, 4, h4, mb4, false, nil, -1)
+	c.requireCode(err, Err_INTERNAL)
+	c.require.Contains(err.Error(), "Previous minipool generation mismatch")
+
+	// Minipool size doesn't match.
+	mb3, h3 := conformanceMb(streamId, 3)
+	err = c.writeMiniblock(streamId, 3, h3, mb3, false, nil, 2)
+	c.requireCode(err, Err_INTERNAL)
+	c.require.Contains(err.Error(), "Previous minipool size mismatch")
+
+	// Failed writes leave storage untouched.
+	result, err := c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, 10)
+	c.require.NoError(err)
+	c.require.Len(result.Miniblocks, 3)
+	c.require.Equal(conformanceEvents("a", 3), result.MinipoolEnvelopes)
+
+	c.require.NoError(c.writeMiniblock(streamId, 3, h3, mb3, false, conformanceEvents("b", 1), 3))
+
+	result, err = c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, 10)
+	c.require.NoError(err)
+	c.require.Len(result.Miniblocks, 4)
+	c.require.Equal(conformanceEvents("b", 1), result.MinipoolEnvelopes)
+
+	// Minipool generation moved forward with the new miniblock.
+	c.requireCode(c.store.WriteEvent(c.ctx, streamId, 3, 1, []byte("event")), Err_DB_OPERATION_FAILURE)
+	c.require.NoError(c.store.WriteEvent(c.ctx, streamId, 4, 1, []byte("event")))
+}
+
+func testConformanceMiniblockCandidates(c *conformanceTest) {
+	streamId, _ := c.createStream(1, nil, nil)
+	mb, h := conformanceMb(streamId, 2)
+
+	// Candidates for already stored miniblocks are rejected.
+	for _, num := range []int64{0, 1} {
+		err := c.store.WriteMiniblockCandidate(c.ctx, streamId, h, num, mb)
+		c.requireCode(err, Err_MINIBLOCKS_STORAGE_FAILURE)
+		c.require.Contains(err.Error(), "Miniblock proposal blockNumber mismatch")
+	}
+
+	c.require.NoError(c.store.WriteMiniblockCandidate(c.ctx, streamId, h, 2, mb))
+	c.requireCode(c.store.WriteMiniblockCandidate(c.ctx, streamId, h, 2, mb), Err_ALREADY_EXISTS)
+
+	// Same hash at other number and other hash at the same number are different candidates.
+	c.require.NoError(c.store.WriteMiniblockCandidate(c.ctx, streamId, h, 3, []byte("other")))
+	h2 := common.BytesToHash([]byte("other"))
+	c.require.NoError(c.store.WriteMiniblockCandidate(c.ctx, streamId, h2, 2, []byte("other")))
+
+	read, err := c.store.ReadMiniblockCandidate(c.ctx, streamId, h, 2)
+	c.require.NoError(err)
+	c.require.Equal(mb, read)
+
+	_, err = c.store.ReadMiniblockCandidate(c.ctx, streamId, h, 4)
+	c.requireCode(err, Err_NOT_FOUND)
+	_, err = c.store.ReadMiniblockCandidate(c.ctx, streamId, common.BytesToHash([]byte("none")), 2)
+	c.requireCode(err, Err_NOT_FOUND)
+
+	debug, err := c.store.DebugReadStreamData(c.ctx, streamId)
+	c.require.NoError(err)
+	c.require.Len(debug.MbCandidates, 3)
+}
+
+func testConformanceCandidateCleanup(c *conformanceTest) {
+	streamId, _ := c.createStream(0, nil, nil)
+	otherStreamId, _ := c.createStream(0, nil, nil)
+
+	mb1, h1 := conformanceMb(streamId, 1)
+	lost := common.BytesToHash([]byte("lost"))
+	mb2, h2 := conformanceMb(streamId, 2)
+	c.require.NoError(c.store.WriteMiniblockCandidate(c.ctx, streamId, h1, 1, mb1))
+	c.require.NoError(c.store.WriteMiniblockCandidate(c.ctx, streamId, lost, 1, []byte("lost")))
+	c.require.NoError(c.store.WriteMiniblockCandidate(c.ctx, streamId, h2, 2, mb2))
+	c.require.NoError(c.store.WriteMiniblockCandidate(c.ctx, otherStreamId, h1, 1, mb1))
+
+	c.require.NoError(c.writeMiniblock(streamId, 1, h1, mb1, false, nil, -1))
+
+	// Candidates up to the written miniblock are deleted.
+	_, err := c.store.ReadMiniblockCandidate(c.ctx, streamId, h1, 1)
+	c.requireCode(err, Err_NOT_FOUND)
+	_, err = c.store.ReadMiniblockCandidate(c.ctx, streamId, lost, 1)
+	c.requireCode(err, Err_NOT_FOUND)
+
+	// Future candidates and candidates of other streams are kept.
+	read, err := c.store.ReadMiniblockCandidate(c.ctx, streamId, h2, 2)
+	c.require.NoError(err)
+	c.require.Equal(mb2, read)
+	read, err = c.store.ReadMiniblockCandidate(c.ctx, otherStreamId, h1, 1)
+	c.require.NoError(err)
+	c.require.Equal(mb1, read)
+
+	c.require.NoError(c.writeMiniblock(streamId, 2, h2, mb2, false, nil, -1))
+
+	debug, err := c.store.DebugReadStreamData(c.ctx, streamId)
+	c.require.NoError(err)
+	c.require.Empty(debug.MbCandidates)
+}
+
+func testConformanceReadStreamFromLastSnapshot(c *conformanceTest) {
+	envelopes := conformanceEvents("a", 5)
+	streamId, mbs := c.createStream(15, []int64{2, 10}, envelopes)
+
+	check := func(numToRead int, start int64, offset int) {
+		c.t.Helper()
+		result, err := c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, numToRead)
+		c.require.NoError(err)
+		c.require.EqualValues(start, result.StartMiniblockNumber, "numToRead=%d", numToRead)
+		c.require.EqualValues(offset, result.SnapshotMiniblockOffset, "numToRead=%d", numToRead)
+		c.require.Equal(mbs[start:], result.Miniblocks, "numToRead=%d", numToRead)
+		c.require.Equal(envelopes, result.MinipoolEnvelopes, "numToRead=%d", numToRead)
+	}
+
+	// Last snapshot is always included.
+	check(0, 10, 0)
+	check(1, 10, 0)
+	check(6, 10, 0)
+	// More miniblocks are returned if requested.
+	check(7, 9, 1)
+	check(14, 2, 8)
+	// But no more than there are in storage.
+	check(100, 0, 10)
+}
+
+func testConformanceReadStreamsFromLastSnapshot(c *conformanceTest) {
+	var streamIds []StreamId
+	for i := range 10 {
+		streamId, _ := c.createStream(int64(i), []int64{int64(i / 2)}, conformanceEvents(fmt.Sprint(i), i%3))
+		streamIds = append(streamIds, streamId)
+	}
+	missing := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	for _, numToRead := range []int{0, 3, 100} {
+		results, err := c.store.ReadStreamsFromLastSnapshot(c.ctx, append(streamIds, missing), numToRead)
+		c.require.NoError(err)
+		c.require.Len(results, len(streamIds))
+		c.require.NotContains(results, missing)
+
+		for _, streamId := range streamIds {
+			expected, err := c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, numToRead)
+			c.require.NoError(err)
+			c.require.Equal(expected, results[streamId], "numToRead=%d", numToRead)
+		}
+	}
+
+	results, err := c.store.ReadStreamsFromLastSnapshot(c.ctx, nil, 1)
+	c.require.NoError(err)
+	c.require.Empty(results)
+}
+
+func testConformanceReadMiniblocks(c *conformanceTest) {
+	streamId, mbs := c.createStream(5, nil, nil)
+
+	read, err := c.store.ReadMiniblocks(c.ctx, streamId, 0, 6)
+	c.require.NoError(err)
+	c.require.Equal(mbs, read)
+
+	read, err = c.store.ReadMiniblocks(c.ctx, streamId, 2, 4)
+	c.require.NoError(err)
+	c.require.Equal(mbs[2:4], read)
+
+	// Range is truncated to the miniblocks present in storage.
+	read, err = c.store.ReadMiniblocks(c.ctx, streamId, 4, 100)
+	c.require.NoError(err)
+	c.require.Equal(mbs[4:], read)
+
+	read, err = c.store.ReadMiniblocks(c.ctx, streamId, 10, 20)
+	c.require.NoError(err)
+	c.require.Empty(read)
+}
+
+func testConformancePruneMiniblocks(c *conformanceTest) {
+	envelopes := conformanceEvents("a", 3)
+	// Genesis miniblock is a snapshot as well.
+	streamId, mbs := c.createStream(15, []int64{3, 7, 12}, envelopes)
+
+	prune := func(retainSnapshots int, expectedFirst int64) {
+		c.t.Helper()
+		first, err := c.store.PruneMiniblocks(c.ctx, streamId, retainSnapshots)
+		c.require.NoError(err)
+		c.require.EqualValues(expectedFirst, first, "retainSnapshots=%d", retainSnapshots)
+	}
+
+	// Nothing is pruned if retention is disabled or there are not enough snapshots.
+	prune(0, 0)
+	prune(5, 0)
+	prune(4, 0)
+
+	read, err := c.store.ReadMiniblocks(c.ctx, streamId, 0, 16)
+	c.require.NoError(err)
+	c.require.Equal(mbs, read)
+
+	prune(2, 7)
+
+	_, err = c.store.ReadMiniblocks(c.ctx, streamId, 0, 16)
+	c.requireCode(err, Err_MINIBLOCKS_PRUNED)
+	_, err = c.store.ReadMiniblocks(c.ctx, streamId, 6, 8)
+	c.requireCode(err, Err_MINIBLOCKS_PRUNED)
+
+	read, err = c.store.ReadMiniblocks(c.ctx, streamId, 7, 16)
+	c.require.NoError(err)
+	c.require.Equal(mbs[7:], read)
+
+	result, err := c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, 100)
+	c.require.NoError(err)
+	c.require.EqualValues(7, result.StartMiniblockNumber)
+	c.require.EqualValues(5, result.SnapshotMiniblockOffset)
+	c.require.Equal(mbs[7:], result.Miniblocks)
+	c.require.Equal(envelopes, result.MinipoolEnvelopes)
+
+	// Pruning never restores history or moves the boundary back.
+	prune(3, 7)
+	prune(0, 7)
+
+	// New snapshots move the boundary forward.
+	mb, h := conformanceMb(streamId, 16)
+	c.require.NoError(c.writeMiniblock(streamId, 16, h, mb, true, nil, len(envelopes)))
+	mbs = append(mbs, mb)
+	prune(1, 16)
+
+	result, err = c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, 100)
+	c.require.NoError(err)
+	c.require.EqualValues(16, result.StartMiniblockNumber)
+	c.require.EqualValues(0, result.SnapshotMiniblockOffset)
+	c.require.Equal(mbs[16:], result.Miniblocks)
+
+	last, err := c.store.StreamLastMiniBlock(c.ctx, streamId)
+	c.require.NoError(err)
+	c.require.EqualValues(16, last.Number)
+
+	// Other streams are not affected.
+	otherId, otherMbs := c.createStream(5, []int64{2, 4}, nil)
+	read, err = c.store.ReadMiniblocks(c.ctx, otherId, 0, 6)
+	c.require.NoError(err)
+	c.require.Equal(otherMbs, read)
+}
+
+func testConformanceArchive(c *conformanceTest) {
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	c.require.NoError(c.store.CreateStreamArchiveStorage(c.ctx, streamId))
+	c.requireCode(c.store.CreateStreamArchiveStorage(c.ctx, streamId), Err_ALREADY_EXISTS)
+
+	// Stream record without archived miniblocks.
+	num, err := c.store.GetMaxArchivedMiniblockNumber(c.ctx, streamId)
+	c.require.NoError(err)
+	c.require.EqualValues(-1, num)
+
+	data := [][]byte{[]byte("mb0"), []byte("mb1"), []byte("mb2")}
+	c.require.Error(c.store.WriteArchiveMiniblocks(c.ctx, streamId, 1, data))
+	c.require.NoError(c.store.WriteArchiveMiniblocks(c.ctx, streamId, 0, data))
+
+	num, err = c.store.GetMaxArchivedMiniblockNumber(c.ctx, streamId)
+	c.require.NoError(err)
+	c.require.EqualValues(2, num)
+
+	data2 := [][]byte{[]byte("mb3"), []byte("mb4")}
+	c.require.Error(c.store.WriteArchiveMiniblocks(c.ctx, streamId, 2, data2))
+	c.require.Error(c.store.WriteArchiveMiniblocks(c.ctx, streamId, 4, data2))
+	c.require.NoError(c.store.WriteArchiveMiniblocks(c.ctx, streamId, 3, data2))
+
+	read, err := c.store.ReadMiniblocks(c.ctx, streamId, 0, 10)
+	c.require.NoError(err)
+	c.require.Equal(append(data, data2...), read)
+
+	// Regular streams report the last miniblock number as well.
+	regularId, _ := c.createStream(3, nil, nil)
+	num, err = c.store.GetMaxArchivedMiniblockNumber(c.ctx, regularId)
+	c.require.NoError(err)
+	c.require.EqualValues(3, num)
+}
+
+const conformanceConcurrency = 8
+
+func testConformanceConcurrentCreate(c *conformanceTest) {
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	var succeeded atomic.Int32
+	var wg sync.WaitGroup
+	errs := make([]error, conformanceConcurrency)
+	for i := range conformanceConcurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = c.store.CreateStreamStorage(c.ctx, streamId, []byte(fmt.Sprintf("genesis-%d", i)))
+			if errs[i] == nil {
+				succeeded.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	c.require.EqualValues(1, succeeded.Load())
+	for _, err := range errs {
+		if err != nil {
+			c.requireCode(err, Err_ALREADY_EXISTS)
+		}
+	}
+}
+
+func testConformanceConcurrentWriteEvent(c *conformanceTest) {
+	streamId, _ := c.createStream(0, nil, nil)
+
+	// All writers compete for the same slot in each round, exactly one of them wins.
+	// Others find the slot taken, as if they were called with the wrong slot.
+	const rounds = 5
+	for slot := range rounds {
+		var succeeded atomic.Int32
+		var wg sync.WaitGroup
+		errs := make([]error, conformanceConcurrency)
+		for i := range conformanceConcurrency {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = c.store.WriteEvent(c.ctx, streamId, 1, slot, []byte(fmt.Sprintf("event-%d-%d", slot, i)))
+				if errs[i] == nil {
+					succeeded.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+		c.require.EqualValues(1, succeeded.Load(), "slot %d", slot)
+		for _, err := range errs {
+			if err != nil {
+				c.requireCode(err, Err_DB_OPERATION_FAILURE)
+				c.require.Contains(err.Error(), "Wrong number of records in minipool")
+			}
+		}
+	}
+
+	result, err := c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, 0)
+	c.require.NoError(err)
+	c.require.Len(result.MinipoolEnvelopes, rounds)
+}
+
+func testConformanceConcurrentStreams(c *conformanceTest) {
+	const numMiniblocks = 5
+
+	var wg sync.WaitGroup
+	streamIds := make([]StreamId, conformanceConcurrency)
+	errs := make([]error, conformanceConcurrency)
+	for i := range conformanceConcurrency {
+		streamIds[i] = testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = func() error {
+				streamId := streamIds[i]
+				genesis, _ := conformanceMb(streamId, 0)
+				if err := c.store.CreateStreamStorage(c.ctx, streamId, genesis); err != nil {
+					return err
+				}
+				for n := int64(1); n <= numMiniblocks; n++ {
+					if err := c.store.WriteEvent(c.ctx, streamId, n, 0, []byte("event")); err != nil {
+						return err
+					}
+					mb, h := conformanceMb(streamId, n)
+					if err := c.store.WriteMiniblockCandidate(c.ctx, streamId, h, n, mb); err != nil {
+						return err
+					}
+					if err := c.writeMiniblock(streamId, n, h, mb, n%2 == 0, nil, 1); err != nil {
+						return err
+					}
+				}
+				return nil
+			}()
+		}()
+	}
+	wg.Wait()
+
+	for i, streamId := range streamIds {
+		c.require.NoError(errs[i])
+		result, err := c.store.ReadStreamFromLastSnapshot(c.ctx, streamId, 0)
+		c.require.NoError(err)
+		c.require.EqualValues(numMiniblocks-1, result.StartMiniblockNumber)
+		mb, _ := conformanceMb(streamId, numMiniblocks)
+		c.require.Equal(mb, result.Miniblocks[len(result.Miniblocks)-1])
+		c.require.Empty(result.MinipoolEnvelopes)
+	}
+}
@@ -137,6 +137,66 @@ func (_m *MockStreamServiceClient) CreateStream(_a0 context.Context, _a1 *connec
 	return r0, r1
 }
 
+// DeleteStream provides a mock function with given fields: _a0, _a1
+func (_m *MockStreamServiceClient) DeleteStream(_a0 context.Context, _a1 *connect.Request[protocol.DeleteStreamRequest]) (*connect.Response[protocol.DeleteStreamResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStream")
+	}
+
+	var r0 *connect.Response[protocol.DeleteStreamResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.DeleteStreamRequest]) (*connect.Response[protocol.DeleteStreamResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.DeleteStreamRequest]) *connect.Response[protocol.DeleteStreamResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[protocol.DeleteStreamResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[protocol.DeleteStreamRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventProof provides a mock function with given fields: _a0, _a1
+func (_m *MockStreamServiceClient) GetEventProof(_a0 context.Context, _a1 *connect.Request[protocol.GetEventProofRequest]) (*connect.Response[protocol.GetEventProofResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEventProof")
+	}
+
+	var r0 *connect.Response[protocol.GetEventProofResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.GetEventProofRequest]) (*connect.Response[protocol.GetEventProofResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.GetEventProofRequest]) *connect.Response[protocol.GetEventProofResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[protocol.GetEventProofResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[protocol.GetEventProofRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLastMiniblockHash provides a mock function with given fields: _a0, _a1
 func (_m *MockStreamServiceClient) GetLastMiniblockHash(_a0 context.Context, _a1 *connect.Request[protocol.GetLastMiniblockHashRequest]) (*connect.Response[protocol.GetLastMiniblockHashResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -227,6 +287,36 @@ func (_m *MockStreamServiceClient) GetStream(_a0 context.Context, _a1 *connect.R
 	return r0, r1
 }
 
+// GetStreamAt provides a mock function with given fields: _a0, _a1
+func (_m *MockStreamServiceClient) GetStreamAt(_a0 context.Context, _a1 *connect.Request[protocol.GetStreamAtRequest]) (*connect.Response[protocol.GetStreamAtResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStreamAt")
+	}
+
+	var r0 *connect.Response[protocol.GetStreamAtResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.GetStreamAtRequest]) (*connect.Response[protocol.GetStreamAtResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.GetStreamAtRequest]) *connect.Response[protocol.GetStreamAtResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[protocol.GetStreamAtResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[protocol.GetStreamAtRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStreamEx provides a mock function with given fields: _a0, _a1
 func (_m *MockStreamServiceClient) GetStreamEx(_a0 context.Context, _a1 *connect.Request[protocol.GetStreamExRequest]) (*connect.ServerStreamForClient[protocol.GetStreamExResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/viper"
 	"golang.org/x/crypto/sha3"
 
+	"github.com/river-build/river/core/node/shared"
 	"github.com/river-build/river/core/node/storage"
 )
 
@@ -395,6 +396,7 @@ CREATE TABLE IF NOT EXISTS %[1]s (
   seq_num BIGINT NOT NULL,
   block_hash CHAR(64) STORAGE PLAIN NOT NULL,
   blockdata BYTEA STORAGE EXTERNAL NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
   PRIMARY KEY (stream_id, seq_num, block_hash)
   )
 `
@@ -1430,6 +1432,182 @@ func compareAllTableCounts(
 	return nil
 }
 
+const maxRepartitionPartitions = 256
+
+func getNumPartitions(ctx context.Context, pool *pgxpool.Pool) (int, error) {
+	var numPartitions int
+	err := pool.QueryRow(ctx, "SELECT num_partitions FROM settings WHERE single_row_key = true").
+		Scan(&numPartitions)
+	if err != nil {
+		return 0, wrapError("Failed to read partition count from settings table", err)
+	}
+	return numPartitions, nil
+}
+
+// createFixedPartitions creates tables for all partitions of the layout with numPartitions partitions.
+// Partitions are shared between layouts, i.e. partition "r01" exists in all layouts with more than one
+// partition, so only tables missing from the current layout are actually created.
+func createFixedPartitions(ctx context.Context, pool *pgxpool.Pool, numPartitions int) error {
+	var sql [][]string
+	for _, streamType := range []string{"m", "r"} {
+		for i := 0; i < numPartitions; i++ {
+			suffix := fmt.Sprintf("%s%02x", streamType, i)
+			sql = append(sql, []string{
+				fmt.Sprintf(miniblocksSql, "miniblocks_"+suffix),
+				fmt.Sprintf(minipoolsSql, "minipools_"+suffix),
+				fmt.Sprintf(miniblockCandidatesSql, "miniblock_candidates_"+suffix),
+			})
+		}
+	}
+	return executeSqlInParallel(ctx, pool, sql, "Partition tables created:", true)
+}
+
+// repartitionStream moves rows of a single stream to the partitions of the new layout.
+// The es row of the stream is locked for the duration of the transaction, the node blocks on
+// the same lock before accessing stream data, so it sees either the old or the new layout.
+func repartitionStream(ctx context.Context, pool *pgxpool.Pool, streamId string, numPartitions int) error {
+	id, err := shared.StreamIdFromString(streamId)
+	if err != nil {
+		return wrapError("Invalid stream id "+streamId, err)
+	}
+
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	})
+	if err != nil {
+		return wrapError("Failed to begin transaction", err)
+	}
+	defer rollbackTx(ctx, tx)
+
+	var migrated bool
+	var oldNumPartitions int
+	err = tx.QueryRow(
+		ctx,
+		"SELECT migrated, num_partitions FROM es WHERE stream_id = $1 FOR UPDATE",
+		streamId,
+	).Scan(&migrated, &oldNumPartitions)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Stream was deleted since the list of streams was read.
+			return nil
+		}
+		return wrapError("Failed to lock stream "+streamId, err)
+	}
+	if !migrated || oldNumPartitions == numPartitions {
+		return nil
+	}
+
+	oldSuffix := storage.CreatePartitionSuffix(id, oldNumPartitions)
+	newSuffix := storage.CreatePartitionSuffix(id, numPartitions)
+	if oldSuffix != newSuffix {
+		for _, table := range []string{"minipools", "miniblocks", "miniblock_candidates"} {
+			oldPartition := table + "_" + oldSuffix
+			newPartition := table + "_" + newSuffix
+			_, err = tx.Exec(
+				ctx,
+				fmt.Sprintf("INSERT INTO %s SELECT * FROM %s WHERE stream_id = $1", newPartition, oldPartition),
+				streamId,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to copy %s to %s for stream %s: %w", oldPartition, newPartition, streamId, err)
+			}
+			_, err = tx.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE stream_id = $1", oldPartition), streamId)
+			if err != nil {
+				return fmt.Errorf("failed to delete from %s for stream %s: %w", oldPartition, streamId, err)
+			}
+		}
+	}
+
+	_, err = tx.Exec(ctx, "UPDATE es SET num_partitions = $2 WHERE stream_id = $1", streamId, numPartitions)
+	if err != nil {
+		return wrapError("Failed to update partition count for stream "+streamId, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return wrapError("Failed to commit transaction", err)
+	}
+	return nil
+}
+
+var (
+	repartitionCmdPartitions int
+	repartitionCmd           = &cobra.Command{
+		Use:   "repartition",
+		Short: "Move streams in source database to a new number of fixed partitions while node is running",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if repartitionCmdPartitions <= 0 || repartitionCmdPartitions > maxRepartitionPartitions {
+				return fmt.Errorf("number of partitions must be between 1 and %d", maxRepartitionPartitions)
+			}
+
+			pool, info, err := getSourceDbPool(ctx, true)
+			if err != nil {
+				return err
+			}
+			err = testDbConnection(ctx, pool, info)
+			if err != nil {
+				return err
+			}
+
+			currentPartitions, err := getNumPartitions(ctx, pool)
+			if err != nil {
+				return err
+			}
+			fmt.Println("Current partitions:", currentPartitions, "target partitions:", repartitionCmdPartitions)
+
+			err = createFixedPartitions(ctx, pool, repartitionCmdPartitions)
+			if err != nil {
+				return err
+			}
+
+			// Switch the layout for new streams first: node reads the partition count under share lock
+			// when creating a stream, so no streams are created in the old layout after this point and
+			// a single pass over es below moves all remaining streams.
+			_, err = pool.Exec(
+				ctx,
+				"UPDATE settings SET num_partitions = $1 WHERE single_row_key = true",
+				repartitionCmdPartitions,
+			)
+			if err != nil {
+				return wrapError("Failed to update partition count in settings table", err)
+			}
+
+			rows, _ := pool.Query(
+				ctx,
+				"SELECT stream_id FROM es WHERE migrated = true AND num_partitions <> $1 ORDER BY stream_id",
+				repartitionCmdPartitions,
+			)
+			streamIds, err := pgx.CollectRows(rows, pgx.RowTo[string])
+			if err != nil {
+				return wrapError("Failed to read streams to repartition", err)
+			}
+			fmt.Println("Streams to repartition:", len(streamIds))
+
+			return executeInParallel(
+				ctx,
+				streamIds,
+				"Streams repartitioned:",
+				func(ctx context.Context, streamIds []string) error {
+					for _, id := range streamIds {
+						err := repartitionStream(ctx, pool, id, repartitionCmdPartitions)
+						if err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+			)
+		},
+	}
+)
+
+func init() {
+	rootCmd.AddCommand(repartitionCmd)
+	repartitionCmd.Flags().IntVar(&repartitionCmdPartitions, "partitions", 0, "New number of partitions")
+}
+
 var validateCmd = &cobra.Command{
 	Use:   "validate",
 	Short: "Validate target database by comparinng counts of objects in each table",